@@ -2,6 +2,7 @@ package main
 
 import (
 	"errors"
+	"fmt"
 	"os"
 
 	"github.com/hidetatz/kubecolor/command"
@@ -17,6 +18,7 @@ func main() {
 		if errors.As(err, &ke) {
 			os.Exit(ke.ExitCode)
 		}
+		fmt.Fprintf(os.Stderr, "kubecolor: %s\n", err)
 		os.Exit(1)
 	}
 }
@@ -2,8 +2,13 @@ package color
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 )
 
+// Color is a foreground color.
+// Basic and bright colors hold their SGR code as is (30-37, 90-97),
+// 256-palette and RGB colors are encoded with a kind flag in the upper bits.
 type Color int
 
 const escape = "\x1b"
@@ -19,10 +24,193 @@ const (
 	White
 )
 
-func (c Color) sequence() int {
-	return int(c)
+const (
+	BrightBlack Color = iota + 90
+	BrightRed
+	BrightGreen
+	BrightYellow
+	BrightBlue
+	BrightMagenta
+	BrightCyan
+	BrightWhite
+)
+
+const (
+	kind256  Color = 1 << 24
+	kindRGB  Color = 1 << 25
+	kindMask       = kind256 | kindRGB
+)
+
+// Color256 returns a color of the 256-color palette.
+func Color256(index uint8) Color {
+	return kind256 | Color(index)
+}
+
+// RGB returns a 24-bit color.
+func RGB(r, g, b uint8) Color {
+	return kindRGB | Color(r)<<16 | Color(g)<<8 | Color(b)
+}
+
+// Level is the range of colors a terminal can show.
+type Level int
+
+const (
+	// LevelBasic is the 8 basic colors and their bright variants.
+	LevelBasic Level = iota
+	// Level256 is the 256-color palette.
+	Level256
+	// LevelTrueColor is 24-bit RGB.
+	LevelTrueColor
+)
+
+// level is the color level of the terminal; Apply falls back to the nearest color it can show.
+var level = LevelTrueColor
+
+// SetLevel sets the color level of the terminal.
+func SetLevel(l Level) {
+	level = l
+}
+
+// DetectLevel guesses the color level of the terminal from environment variables.
+func DetectLevel(getenv func(string) string) Level {
+	switch strings.ToLower(getenv("COLORTERM")) {
+	case "truecolor", "24bit":
+		return LevelTrueColor
+	}
+
+	if getenv("WT_SESSION") != "" { // Windows Terminal
+		return LevelTrueColor
+	}
+
+	term := getenv("TERM")
+	switch {
+	case strings.Contains(term, "truecolor"), strings.Contains(term, "direct"):
+		return LevelTrueColor
+	case strings.Contains(term, "256color"):
+		return Level256
+	}
+
+	return LevelBasic
+}
+
+var names = map[string]Color{
+	"black":   Black,
+	"red":     Red,
+	"green":   Green,
+	"yellow":  Yellow,
+	"blue":    Blue,
+	"magenta": Magenta,
+	"cyan":    Cyan,
+	"white":   White,
+}
+
+// Parse returns a Color by its description. These forms are accepted:
+//
+//	red            basic color
+//	bright-red     bright color
+//	245            index of the 256-color palette
+//	#ff8700        24-bit RGB
+func Parse(name string) (Color, error) {
+	s := strings.ToLower(strings.TrimSpace(name))
+
+	if c, ok := names[s]; ok {
+		return c, nil
+	}
+
+	if strings.HasPrefix(s, "bright-") {
+		if c, ok := names[strings.TrimPrefix(s, "bright-")]; ok {
+			return c + (BrightBlack - Black), nil
+		}
+	}
+
+	if strings.HasPrefix(s, "#") && len(s) == 7 {
+		if v, err := strconv.ParseUint(s[1:], 16, 32); err == nil {
+			return RGB(uint8(v>>16), uint8(v>>8), uint8(v)), nil
+		}
+	}
+
+	if v, err := strconv.ParseUint(s, 10, 8); err == nil {
+		return Color256(uint8(v)), nil
+	}
+
+	return 0, fmt.Errorf("unknown color %q", name)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler so colors can be written by name in config files.
+func (c *Color) UnmarshalText(text []byte) error {
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+	return nil
+}
+
+// Downgrade returns the nearest color which can be shown in the given level.
+func (c Color) Downgrade(l Level) Color {
+	switch c & kindMask {
+	case kindRGB:
+		switch l {
+		case LevelTrueColor:
+			return c
+		case Level256:
+			return Color256(nearest256(c.rgb()))
+		default:
+			return nearestBasic(c.rgb())
+		}
+	case kind256:
+		if l == LevelBasic {
+			return nearestBasic(c.rgb())
+		}
+	}
+
+	return c
 }
 
-func Apply(val string, c Color) string {
-	return fmt.Sprintf("%s[%dm%s%s[0m", escape, c.sequence(), val, escape)
+// sgr returns the SGR parameters of c as a foreground color in the given level.
+func (c Color) sgr(l Level) string {
+	return c.Downgrade(l).sequence()
+}
+
+func (c Color) sequence() string {
+	switch c & kindMask {
+	case kind256:
+		return fmt.Sprintf("38;5;%d", int(c&0xff))
+	case kindRGB:
+		r, g, b := c.rgb()
+		return fmt.Sprintf("38;2;%d;%d;%d", r, g, b)
+	}
+
+	return strconv.Itoa(int(c))
+}
+
+// bgSequence returns the SGR parameters of c as a background color.
+func (c Color) bgSequence() string {
+	switch c & kindMask {
+	case kind256:
+		return fmt.Sprintf("48;5;%d", int(c&0xff))
+	case kindRGB:
+		r, g, b := c.rgb()
+		return fmt.Sprintf("48;2;%d;%d;%d", r, g, b)
+	}
+
+	// background colors are 10 larger than foreground ones (40-47, 100-107)
+	return strconv.Itoa(int(c) + 10)
+}
+
+// Styler is something Apply can render; Color and Style implement it.
+type Styler interface {
+	sgr(l Level) string
+}
+
+// Apply decorates val with s, then resets the decoration at the end.
+// An empty Style leaves val as is.
+func Apply(val string, s Styler) string {
+	sgr := s.sgr(level)
+	if sgr == "" {
+		return val
+	}
+
+	return fmt.Sprintf("%s[%sm%s%s[0m", escape, sgr, val, escape)
 }
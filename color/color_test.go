@@ -11,3 +11,141 @@ func TestApply(t *testing.T) {
 		t.Fatalf("failed: %v", applied)
 	}
 }
+
+func TestApply_Levels(t *testing.T) {
+	tests := []struct {
+		name     string
+		level    Level
+		color    Color
+		expected string
+	}{
+		{"bright", LevelBasic, BrightWhite, "\x1b[97mtest\x1b[0m"},
+		{"256", Level256, Color256(245), "\x1b[38;5;245mtest\x1b[0m"},
+		{"rgb", LevelTrueColor, RGB(255, 135, 0), "\x1b[38;2;255;135;0mtest\x1b[0m"},
+		{"rgb falls back to 256", Level256, RGB(255, 135, 0), "\x1b[38;5;208mtest\x1b[0m"},
+		{"gray rgb falls back to 256 gray", Level256, RGB(100, 100, 100), "\x1b[38;5;241mtest\x1b[0m"},
+		{"rgb falls back to basic", LevelBasic, RGB(250, 10, 10), "\x1b[91mtest\x1b[0m"},
+		{"256 falls back to basic", LevelBasic, Color256(34), "\x1b[32mtest\x1b[0m"},
+		{"basic is kept", LevelBasic, Cyan, "\x1b[36mtest\x1b[0m"},
+	}
+	defer SetLevel(LevelTrueColor)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetLevel(tt.level)
+			if applied := Apply("test", tt.color); applied != tt.expected {
+				t.Fatalf("got %q, expected %q", applied, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDetectLevel(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		expected Level
+	}{
+		{"nothing", map[string]string{}, LevelBasic},
+		{"xterm", map[string]string{"TERM": "xterm"}, LevelBasic},
+		{"256color", map[string]string{"TERM": "xterm-256color"}, Level256},
+		{"COLORTERM", map[string]string{"TERM": "xterm-256color", "COLORTERM": "truecolor"}, LevelTrueColor},
+		{"24bit", map[string]string{"COLORTERM": "24bit"}, LevelTrueColor},
+		{"windows terminal", map[string]string{"WT_SESSION": "abc"}, LevelTrueColor},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got := DetectLevel(func(key string) string { return tt.env[key] })
+			if got != tt.expected {
+				t.Fatalf("got %v, expected %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name      string
+		expected  Color
+		expectErr bool
+	}{
+		{"red", Red, false},
+		{"Cyan", Cyan, false},
+		{" white ", White, false},
+		{"bright-white", BrightWhite, false},
+		{"bright-black", BrightBlack, false},
+		{"245", Color256(245), false},
+		{"#FF8700", RGB(255, 135, 0), false},
+		{"purple", 0, true},
+		{"bright-purple", 0, true},
+		{"256", 0, true},
+		{"#ff87", 0, true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.name)
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Fatalf("got %v, expected %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestApply_Styles(t *testing.T) {
+	tests := []struct {
+		name     string
+		style    Style
+		expected string
+	}{
+		{"empty", Style{}, "test"},
+		{"attribute only", Style{Attrs: Bold}, "\x1b[1mtest\x1b[0m"},
+		{"attributes and color", Style{Fg: Yellow, Attrs: Dim | Underline}, "\x1b[2;4;33mtest\x1b[0m"},
+		{"background", Style{Fg: White, Bg: Red}, "\x1b[37;41mtest\x1b[0m"},
+		{"bright background", Style{Bg: BrightBlue}, "\x1b[104mtest\x1b[0m"},
+		{"256 background", Style{Attrs: Reverse, Bg: Color256(236)}, "\x1b[7;48;5;236mtest\x1b[0m"},
+		{"rgb background", Style{Bg: RGB(1, 2, 3)}, "\x1b[48;2;1;2;3mtest\x1b[0m"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if applied := Apply("test", tt.style); applied != tt.expected {
+				t.Fatalf("got %q, expected %q", applied, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseStyle(t *testing.T) {
+	tests := []struct {
+		desc      string
+		expected  Style
+		expectErr bool
+	}{
+		{"", Style{}, false},
+		{"red", Style{Fg: Red}, false},
+		{"bold", Style{Attrs: Bold}, false},
+		{"dim yellow", Style{Fg: Yellow, Attrs: Dim}, false},
+		{"Bold Underline #ff8700 on 236", Style{Fg: RGB(255, 135, 0), Bg: Color256(236), Attrs: Bold | Underline}, false},
+		{"italic reverse on bright-red", Style{Bg: BrightRed, Attrs: Italic | Reverse}, false},
+		{"red blue", Style{}, true},
+		{"red on", Style{}, true},
+		{"blinking red", Style{}, true},
+		{"red on purple", Style{}, true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.desc, func(t *testing.T) {
+			got, err := ParseStyle(tt.desc)
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Fatalf("got %+v, expected %+v", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package color
+
+// basicRGB is the RGB values of the 16 basic colors (xterm defaults).
+// Index 0-7 are Black-White and 8-15 are BrightBlack-BrightWhite.
+var basicRGB = [16][3]uint8{
+	{0, 0, 0}, {205, 0, 0}, {0, 205, 0}, {205, 205, 0},
+	{0, 0, 238}, {205, 0, 205}, {0, 205, 205}, {229, 229, 229},
+	{127, 127, 127}, {255, 0, 0}, {0, 255, 0}, {255, 255, 0},
+	{92, 92, 255}, {255, 0, 255}, {0, 255, 255}, {255, 255, 255},
+}
+
+// cubeLevels is the intensities of the 6x6x6 color cube in the 256-color palette (index 16-231).
+var cubeLevels = [6]uint8{0, 95, 135, 175, 215, 255}
+
+// rgb returns the RGB value of c.
+func (c Color) rgb() (uint8, uint8, uint8) {
+	switch c & kindMask {
+	case kindRGB:
+		return uint8(c >> 16), uint8(c >> 8), uint8(c)
+	case kind256:
+		return paletteRGB(uint8(c))
+	}
+
+	switch {
+	case c >= Black && c <= White:
+		v := basicRGB[c-Black]
+		return v[0], v[1], v[2]
+	case c >= BrightBlack && c <= BrightWhite:
+		v := basicRGB[c-BrightBlack+8]
+		return v[0], v[1], v[2]
+	}
+
+	return 0, 0, 0
+}
+
+// paletteRGB returns the RGB value of the 256-color palette index.
+func paletteRGB(index uint8) (uint8, uint8, uint8) {
+	switch {
+	case index < 16:
+		v := basicRGB[index]
+		return v[0], v[1], v[2]
+	case index < 232:
+		i := index - 16
+		return cubeLevels[i/36], cubeLevels[i/6%6], cubeLevels[i%6]
+	default:
+		gray := 8 + (index-232)*10
+		return gray, gray, gray
+	}
+}
+
+// nearest256 returns the 256-color palette index which is the nearest to the given RGB.
+// Only the color cube and the gray ramp are candidates because
+// the first 16 colors are often redefined by terminal color schemes.
+func nearest256(r, g, b uint8) uint8 {
+	cube := 16 + 36*nearestCubeLevel(r) + 6*nearestCubeLevel(g) + nearestCubeLevel(b)
+
+	avg := (int(r) + int(g) + int(b)) / 3
+	grayIndex := 23
+	if avg < 8 {
+		grayIndex = 0
+	} else if avg < 238 {
+		grayIndex = (avg - 8) / 10
+	}
+	gray := uint8(232 + grayIndex)
+
+	cr, cg, cb := paletteRGB(cube)
+	gr, gg, gb := paletteRGB(gray)
+	if distance(r, g, b, gr, gg, gb) < distance(r, g, b, cr, cg, cb) {
+		return gray
+	}
+	return cube
+}
+
+func nearestCubeLevel(v uint8) uint8 {
+	best := uint8(0)
+	for i, l := range cubeLevels {
+		if abs(int(v)-int(l)) < abs(int(v)-int(cubeLevels[best])) {
+			best = uint8(i)
+		}
+	}
+	return best
+}
+
+// nearestBasic returns the basic or bright color which is the nearest to the given RGB.
+func nearestBasic(r, g, b uint8) Color {
+	best, bestDistance := 0, -1
+	for i, v := range basicRGB {
+		if d := distance(r, g, b, v[0], v[1], v[2]); bestDistance < 0 || d < bestDistance {
+			best, bestDistance = i, d
+		}
+	}
+
+	if best < 8 {
+		return Black + Color(best)
+	}
+	return BrightBlack + Color(best-8)
+}
+
+func distance(r1, g1, b1, r2, g2, b2 uint8) int {
+	dr, dg, db := int(r1)-int(r2), int(g1)-int(g2), int(b1)-int(b2)
+	return dr*dr + dg*dg + db*db
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
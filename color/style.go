@@ -0,0 +1,123 @@
+package color
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Attribute is a text attribute. Attributes can be combined with "|".
+type Attribute int
+
+const (
+	Bold Attribute = 1 << iota
+	Dim
+	Italic
+	Underline
+	Reverse
+)
+
+// attributes is the list of attributes in the order of their SGR codes.
+var attributes = []struct {
+	attr Attribute
+	name string
+	code string
+}{
+	{Bold, "bold", "1"},
+	{Dim, "dim", "2"},
+	{Italic, "italic", "3"},
+	{Underline, "underline", "4"},
+	{Reverse, "reverse", "7"},
+}
+
+// Style is a combination of foreground color, background color and attributes.
+// Zero values mean "not specified", so Style{Fg: Red} is just red text.
+type Style struct {
+	Fg    Color
+	Bg    Color
+	Attrs Attribute
+}
+
+func (s Style) sgr(l Level) string {
+	var params []string
+	for _, a := range attributes {
+		if s.Attrs&a.attr != 0 {
+			params = append(params, a.code)
+		}
+	}
+
+	if s.Fg != 0 {
+		params = append(params, s.Fg.sgr(l))
+	}
+
+	if s.Bg != 0 {
+		params = append(params, s.Bg.Downgrade(l).bgSequence())
+	}
+
+	return strings.Join(params, ";")
+}
+
+// ParseStyle returns a Style by its description, which is a space-separated list of
+// attributes, a foreground color and "on" followed by a background color. e.g.
+//
+//	bold
+//	dim yellow
+//	bold underline #ff8700 on 236
+//
+// Colors are written in the forms Parse accepts.
+func ParseStyle(desc string) (Style, error) {
+	var s Style
+	fields := strings.Fields(strings.ToLower(desc))
+	for i := 0; i < len(fields); i++ {
+		f := fields[i]
+
+		if attr, ok := parseAttribute(f); ok {
+			s.Attrs |= attr
+			continue
+		}
+
+		if f == "on" {
+			if i+1 >= len(fields) {
+				return Style{}, fmt.Errorf("background color is missing in %q", desc)
+			}
+			bg, err := Parse(fields[i+1])
+			if err != nil {
+				return Style{}, err
+			}
+			s.Bg = bg
+			i++
+			continue
+		}
+
+		if s.Fg != 0 {
+			return Style{}, fmt.Errorf("more than one foreground color in %q", desc)
+		}
+		fg, err := Parse(f)
+		if err != nil {
+			return Style{}, err
+		}
+		s.Fg = fg
+	}
+
+	return s, nil
+}
+
+func parseAttribute(name string) (Attribute, bool) {
+	for _, a := range attributes {
+		if a.name == name {
+			return a.attr, true
+		}
+	}
+
+	return 0, false
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler so styles can be written in config files.
+func (s *Style) UnmarshalText(text []byte) error {
+	parsed, err := ParseStyle(string(text))
+	if err != nil {
+		return err
+	}
+
+	*s = parsed
+	return nil
+}
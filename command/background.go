@@ -0,0 +1,114 @@
+package command
+
+import (
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// backgroundQueryTimeout is how long kubecolor waits for the terminal to tell its background color.
+const backgroundQueryTimeout = 100 * time.Millisecond
+
+const (
+	// osc11Query asks the background color of the terminal.
+	osc11Query = "\x1b]11;?\x1b\\"
+	// da1Query asks the device attributes. Every terminal answers it,
+	// so its answer tells that the answer to osc11Query would never come.
+	da1Query = "\x1b[c"
+)
+
+var (
+	// e.g. "\x1b]11;rgb:1e1e/1e1e/1e1e\x1b\\"
+	osc11Response = regexp.MustCompile(`\x1b\]11;rgba?:([0-9a-fA-F]{1,4})/([0-9a-fA-F]{1,4})/([0-9a-fA-F]{1,4})`)
+	// e.g. "\x1b[?62;22c"
+	da1Response = regexp.MustCompile(`\x1b\[\?[0-9;]*c`)
+)
+
+// mocked in unit tests
+var detectBackground = func() (dark, ok bool) {
+	if dark, ok := queryTerminalBackground(); ok {
+		return dark, true
+	}
+
+	return backgroundFromColorFgBg(getenv("COLORFGBG"))
+}
+
+// terminal is what queryBackground needs to talk with the terminal. *os.File implements it.
+type terminal interface {
+	io.ReadWriter
+	SetReadDeadline(t time.Time) error
+}
+
+// queryBackground asks the terminal its background color with OSC 11 and returns if it is dark.
+// ok is false when the terminal doesn't answer within timeout.
+func queryBackground(t terminal, timeout time.Duration) (dark, ok bool) {
+	if _, err := io.WriteString(t, osc11Query+da1Query); err != nil {
+		return false, false
+	}
+
+	if err := t.SetReadDeadline(time.Now().Add(timeout)); err != nil {
+		return false, false
+	}
+
+	var resp []byte
+	buf := make([]byte, 64)
+	for !da1Response.Match(resp) {
+		n, err := t.Read(buf)
+		resp = append(resp, buf[:n]...)
+		if err != nil {
+			break
+		}
+	}
+
+	r, g, b, ok := parseOSC11(string(resp))
+	if !ok {
+		return false, false
+	}
+
+	return luminance(r, g, b) < 0.5, true
+}
+
+// parseOSC11 parses the answer to osc11Query and returns the color in the range of [0, 1].
+// Each component can have 1 to 4 hex digits, e.g. "rgb:ff/ff/ff" and "rgb:ffff/ffff/ffff" are the same.
+func parseOSC11(resp string) (r, g, b float64, ok bool) {
+	m := osc11Response.FindStringSubmatch(resp)
+	if m == nil {
+		return 0, 0, 0, false
+	}
+
+	rgb := make([]float64, 3)
+	for i, hex := range m[1:] {
+		v, err := strconv.ParseUint(hex, 16, 16)
+		if err != nil {
+			return 0, 0, 0, false
+		}
+		max := uint64(1)<<(4*len(hex)) - 1
+		rgb[i] = float64(v) / float64(max)
+	}
+
+	return rgb[0], rgb[1], rgb[2], true
+}
+
+// luminance returns the perceived brightness of the color in the range of [0, 1].
+func luminance(r, g, b float64) float64 {
+	return 0.2126*r + 0.7152*g + 0.0722*b
+}
+
+// backgroundFromColorFgBg guesses the background by COLORFGBG which some terminals (e.g. rxvt, Konsole) set.
+// It looks like "15;0" or "15;default;0" and the last field is the palette index of the background.
+func backgroundFromColorFgBg(colorFgBg string) (dark, ok bool) {
+	if colorFgBg == "" {
+		return false, false
+	}
+
+	fields := strings.Split(colorFgBg, ";")
+	bg, err := strconv.Atoi(fields[len(fields)-1])
+	if err != nil || bg < 0 || bg > 15 {
+		return false, false
+	}
+
+	// 7 (white) and 9-15 (bright colors) are light
+	return bg < 7 || bg == 8, true
+}
@@ -0,0 +1,83 @@
+package command
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hidetatz/kubecolor/testutil"
+)
+
+// fakeTerminal answers the queries with answer, then times out.
+type fakeTerminal struct {
+	answer  *strings.Reader
+	written bytes.Buffer
+}
+
+func (f *fakeTerminal) Write(p []byte) (int, error) { return f.written.Write(p) }
+
+func (f *fakeTerminal) Read(p []byte) (int, error) {
+	n, err := f.answer.Read(p)
+	if err == io.EOF {
+		return n, os.ErrDeadlineExceeded
+	}
+	return n, err
+}
+
+func (f *fakeTerminal) SetReadDeadline(t time.Time) error { return nil }
+
+func Test_queryBackground(t *testing.T) {
+	tests := []struct {
+		name         string
+		answer       string
+		expectedDark bool
+		expectedOk   bool
+	}{
+		{"black", "\x1b]11;rgb:0000/0000/0000\x1b\\\x1b[?62;22c", true, true},
+		{"white", "\x1b]11;rgb:ffff/ffff/ffff\x1b\\\x1b[?62;22c", false, true},
+		{"2 digits, BEL terminated", "\x1b]11;rgb:fd/f6/e3\a\x1b[?1;2c", false, true},
+		{"dark gray", "\x1b]11;rgb:2828/2c2c/3434\x1b\\\x1b[?62c", true, true},
+		{"rgba", "\x1b]11;rgba:ffff/ffff/ffff/ffff\x1b\\\x1b[?62c", false, true},
+		{"osc 11 is not supported", "\x1b[?62;22c", false, false},
+		{"no answer", "", false, false},
+		{"broken answer", "\x1b]11;rgb:zz/zz/zz\x1b\\\x1b[?62c", false, false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			term := &fakeTerminal{answer: strings.NewReader(tt.answer)}
+			dark, ok := queryBackground(term, time.Second)
+			testutil.MustEqual(t, tt.expectedOk, ok)
+			testutil.MustEqual(t, tt.expectedDark, dark)
+			testutil.MustEqual(t, osc11Query+da1Query, term.written.String())
+		})
+	}
+}
+
+func Test_backgroundFromColorFgBg(t *testing.T) {
+	tests := []struct {
+		colorFgBg    string
+		expectedDark bool
+		expectedOk   bool
+	}{
+		{"15;0", true, true},
+		{"0;15", false, true},
+		{"15;default;0", true, true},
+		{"0;7", false, true},
+		{"7;8", true, true},
+		{"", false, false},
+		{"15;default", false, false},
+		{"15;99", false, false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.colorFgBg, func(t *testing.T) {
+			dark, ok := backgroundFromColorFgBg(tt.colorFgBg)
+			testutil.MustEqual(t, tt.expectedOk, ok)
+			testutil.MustEqual(t, tt.expectedDark, dark)
+		})
+	}
+}
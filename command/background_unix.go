@@ -0,0 +1,34 @@
+//go:build !windows
+
+package command
+
+import (
+	"os"
+
+	"golang.org/x/term"
+)
+
+// queryTerminalBackground asks the controlling terminal its background color.
+func queryTerminalBackground() (dark, ok bool) {
+	tty, err := os.OpenFile("/dev/tty", os.O_RDWR, 0)
+	if err != nil {
+		return false, false
+	}
+	defer tty.Close()
+
+	// the terminal must be in raw mode so that the answer is neither echoed nor line-buffered.
+	// tty.Fd() is not used because it disables read deadlines.
+	rc, err := tty.SyscallConn()
+	if err != nil {
+		return false, false
+	}
+
+	var state *term.State
+	var rawErr error
+	if err := rc.Control(func(fd uintptr) { state, rawErr = term.MakeRaw(int(fd)) }); err != nil || rawErr != nil {
+		return false, false
+	}
+	defer rc.Control(func(fd uintptr) { term.Restore(int(fd), state) })
+
+	return queryBackground(tty, backgroundQueryTimeout)
+}
@@ -0,0 +1,8 @@
+//go:build windows
+
+package command
+
+// queryTerminalBackground is not supported on Windows; COLORFGBG is used instead.
+func queryTerminalBackground() (dark, ok bool) {
+	return false, false
+}
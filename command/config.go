@@ -1,42 +1,219 @@
 package command
 
-import "os"
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/hidetatz/kubecolor/kubectl"
+	"github.com/hidetatz/kubecolor/printer"
+)
+
+// mocked in unit tests
+var (
+	getenv = os.Getenv
+	// warnings tell the settings which are ignored. They must not stop kubectl from running.
+	warnings io.Writer = os.Stderr
+)
+
+// Every kubecolor flag has an equivalent environment variable.
+const (
+	plainEnv            = "KUBECOLOR_PLAIN"
+	lightBackgroundEnv  = "KUBECOLOR_LIGHT_BACKGROUND"
+	forceColorsEnv      = "KUBECOLOR_FORCE_COLORS"
+	kubecolorVersionEnv = "KUBECOLOR_SHOW_VERSION" // not KUBECOLOR_VERSION, which is often used to pin the version to install
+	useOcCliEnv         = "KUBECOLOR_USE_OC_CLI"
+)
 
 type KubecolorConfig struct {
-	Plain                bool
-	DarkBackground       bool
+	Plain          bool
+	DarkBackground bool
+	// AutoBackground is true when neither flags, env nor the config file tell the background.
+	// Then it's asked to the terminal once the command is known to be colorized (see resolveBackground).
+	AutoBackground       bool
 	ForceColor           bool
 	ShowKubecolorVersion bool
 	KubectlCmd           string
 	UseOcCli             bool
+	Theme                string
+	LogFormat            printer.LogFormat
+	// Statuses is nil when the config file adds no status words, then printers use the built-in ones.
+	Statuses printer.StatusRegistry
+	// Thresholds is nil when the config file doesn't override them, then printers use the defaults.
+	Thresholds *printer.Thresholds
+	TopBars    bool
+	// StableColors colors the identities e.g. namespaces and nodes by the hash of their text instead of the column positions.
+	StableColors bool
+
+	// Subcommands enables or disables colorizing per subcommand, e.g. {"logs": false}.
+	// Subcommands which are not listed here follow the default behavior.
+	Subcommands map[string]bool
 }
 
-func ResolveConfig(args []string) ([]string, *KubecolorConfig) {
+// ResolveConfig builds KubecolorConfig from command line flags, environment variables and the config file.
+// When the same setting is given in several places, flags win over env, env wins over the file,
+// and the file wins over the defaults.
+func ResolveConfig(args []string) ([]string, *KubecolorConfig, error) {
 	args, plainFlagFound := findAndRemoveBoolFlagIfExists(args, "--plain")
 	args, lightBackgroundFlagFound := findAndRemoveBoolFlagIfExists(args, "--light-background")
 	args, forceColorFlagFound := findAndRemoveBoolFlagIfExists(args, "--force-colors")
 	args, kubecolorVersionFlagFound := findAndRemoveBoolFlagIfExists(args, "--kubecolor-version")
 	args, useOcCliFlagFound := findAndRemoveBoolFlagIfExists(args, "--use-oc-cli")
 
-	darkBackground := !lightBackgroundFlagFound
+	fc, err := readConfigFile(configFilePath())
+	if err != nil {
+		return args, nil, err
+	}
+
+	// --plain and --force-colors decide together; if either is passed,
+	// the lower layers must not turn on the other one.
+	plain, forceColor := plainFlagFound, forceColorFlagFound
+	if !plainFlagFound && !forceColorFlagFound {
+		var decided bool
+		plain, forceColor, decided = colorModeFromEnv()
+
+		if !decided {
+			if fc.Plain != nil {
+				plain = *fc.Plain
+			}
+			if fc.ForceColors != nil {
+				forceColor = *fc.ForceColors
+			}
+		}
+	}
+
+	// CLICOLOR=0 and TERM=dumb tell that the terminal doesn't want colors,
+	// but forced colors still win as CLICOLOR_FORCE does.
+	if !forceColor && (getenv("CLICOLOR") == "0" || getenv("TERM") == "dumb") {
+		plain = true
+	}
+
+	lightBackgroundEnvValue, lightBackgroundEnvFound := envBool(lightBackgroundEnv)
+
+	darkBackground, autoBackground := true, false
+	switch {
+	case lightBackgroundFlagFound:
+		darkBackground = false
+	case lightBackgroundEnvFound:
+		darkBackground = !lightBackgroundEnvValue
+	case fc.Background == "dark", fc.Background == "light":
+		darkBackground = fc.Background == "dark"
+	default:
+		autoBackground = true
+	}
+
+	showKubecolorVersion := kubecolorVersionFlagFound
+	if !showKubecolorVersion {
+		showKubecolorVersion, _ = envBool(kubecolorVersionEnv)
+	}
+
+	useOcCli := useOcCliFlagFound
+	if !useOcCli {
+		useOcCli, _ = envBool(useOcCliEnv)
+	}
 
 	kubectlCmd := "kubectl"
-	if useOcCliFlagFound {
+	if useOcCli {
 		kubectlCmd = "oc"
-	} else {
-		if kc := os.Getenv("KUBECTL_COMMAND"); kc != "" {
-			kubectlCmd = kc
-		}
+	} else if kc := getenv("KUBECTL_COMMAND"); kc != "" {
+		kubectlCmd = kc
+	} else if fc.Kubectl != "" {
+		kubectlCmd = fc.Kubectl
+	}
+
+	var statuses printer.StatusRegistry
+	if len(fc.Statuses) > 0 {
+		statuses = printer.NewStatusRegistry(fc.Statuses)
 	}
 
 	return args, &KubecolorConfig{
-		Plain:                plainFlagFound,
+		Plain:                plain,
 		DarkBackground:       darkBackground,
-		ForceColor:           forceColorFlagFound,
-		ShowKubecolorVersion: kubecolorVersionFlagFound,
+		AutoBackground:       autoBackground,
+		ForceColor:           forceColor,
+		ShowKubecolorVersion: showKubecolorVersion,
 		KubectlCmd:           kubectlCmd,
-		UseOcCli:             useOcCliFlagFound,
+		UseOcCli:             useOcCli,
+		Theme:                fc.Theme,
+		LogFormat:            printer.LogFormat(fc.LogFormat),
+		Statuses:             statuses,
+		Thresholds:           fc.thresholds(),
+		TopBars:              fc.TopBars,
+		StableColors:         fc.StableColors,
+		Subcommands:          fc.Subcommands,
+	}, nil
+}
+
+// colorModeFromEnv resolves plain and forceColor from environment variables.
+// decided is false when none of them is set.
+// KUBECOLOR_* variables have priority over NO_COLOR (https://no-color.org)
+// and CLICOLOR_FORCE (https://bixense.com/clicolors) respectively.
+func colorModeFromEnv() (plain, forceColor, decided bool) {
+	plain, plainSet := envBool(plainEnv)
+	if !plainSet && getenv("NO_COLOR") != "" {
+		plain, plainSet = true, true
 	}
+
+	forceColor, forceColorSet := envBool(forceColorsEnv)
+	if v := getenv("CLICOLOR_FORCE"); !forceColorSet && v != "" && v != "0" {
+		forceColor, forceColorSet = true, true
+	}
+
+	return plain, forceColor, plainSet || forceColorSet
+}
+
+// envBool reads a boolean environment variable. ok is false when it is not set.
+// The variables can be set by CI runners and editors kubecolor doesn't know,
+// so an invalid value is ignored with a warning instead of failing the command.
+func envBool(key string) (value, ok bool) {
+	v := getenv(key)
+	if v == "" {
+		return false, false
+	}
+
+	value, err := strconv.ParseBool(v)
+	if err != nil {
+		fmt.Fprintf(warnings, "kubecolor: %s=%q is ignored, it must be true or false\n", key, v)
+		return false, false
+	}
+
+	return value, true
+}
+
+// resolveBackground asks the terminal its background when config leaves it to the terminal.
+// Asking puts the terminal in raw mode for a moment and its answer can come late,
+// so it's done only when the output is colorized on the terminal by a command which doesn't read the keyboard.
+func resolveBackground(config *KubecolorConfig, shouldColorize bool, subcommandInfo *kubectl.CLICommandInfo) {
+	if !config.AutoBackground || config.Theme != "" || !shouldColorize || !isOutputTerminal() || isInteractive(subcommandInfo) {
+		return
+	}
+
+	if dark, ok := detectBackground(); ok {
+		config.DarkBackground = dark
+	}
+}
+
+// isInteractive returns true when the command can read the keyboard or the standard input of the terminal,
+// e.g. "kubectl exec -it" and "kubectl edit".
+func isInteractive(info *kubectl.CLICommandInfo) bool {
+	switch info.Subcommand {
+	case kubectl.Exec, kubectl.Attach, kubectl.Edit, kubectl.Debug, kubectl.Run, kubectl.PortForward, kubectl.Proxy, kubectl.Cp:
+		return true
+	}
+
+	return false
+}
+
+// resolveTheme returns the theme named in config.
+// When no theme is configured, the built-in one for the background is used.
+// A configured theme wins over the background, because it's made for either background.
+func resolveTheme(config *KubecolorConfig) (*printer.Theme, error) {
+	if config.Theme == "" {
+		return printer.ThemeForBackground(config.DarkBackground), nil
+	}
+
+	return printer.LoadTheme(config.Theme, themesDir())
 }
 
 func findAndRemoveBoolFlagIfExists(args []string, key string) ([]string, bool) {
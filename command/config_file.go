@@ -0,0 +1,167 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hidetatz/kubecolor/printer"
+	"gopkg.in/yaml.v3"
+)
+
+// configFileEnv is an environment variable to override the config file path.
+const configFileEnv = "KUBECOLOR_CONFIG"
+
+// fileConfig is the representation of the kubecolor config file.
+// e.g.
+//
+//	background: light
+//	forceColors: true
+//	kubectl: kubectl.1.19
+//	theme: solarized
+//	logFormat: json
+//	statuses:
+//	  unhealthy: [Degraded, OutOfSync]
+//	thresholds:
+//	  restartsWarning: 0
+//	  youngAge: 10m
+//	topBars: true
+//	stableColors: true
+//	subcommands:
+//	  logs: false
+//
+// Pointer fields are used to distinguish "not set" from the zero value.
+type fileConfig struct {
+	Background  string          `yaml:"background"`
+	Plain       *bool           `yaml:"plain"`
+	ForceColors *bool           `yaml:"forceColors"`
+	Kubectl     string          `yaml:"kubectl"`
+	Theme       string          `yaml:"theme"`
+	LogFormat   string          `yaml:"logFormat"`
+	Subcommands map[string]bool `yaml:"subcommands"`
+
+	// Statuses adds status words to the built-in ones per severity.
+	Statuses map[printer.StatusSeverity][]string `yaml:"statuses"`
+
+	// Thresholds overrides the default thresholds which are set.
+	Thresholds *fileThresholds `yaml:"thresholds"`
+
+	// TopBars shows bars next to the percentages in kubectl top.
+	TopBars bool `yaml:"topBars"`
+
+	// StableColors colors namespaces, nodes and the names of the same owner by the hash of their text,
+	// so they look the same in every command.
+	StableColors bool `yaml:"stableColors"`
+}
+
+// fileThresholds is the thresholds in the config file. Pointer fields are nil when they are not set.
+type fileThresholds struct {
+	RestartsWarning *int           `yaml:"restartsWarning"`
+	RestartsError   *int           `yaml:"restartsError"`
+	YoungAge        *time.Duration `yaml:"youngAge"`
+	UsageWarning    *int           `yaml:"usageWarning"`
+	UsageError      *int           `yaml:"usageError"`
+}
+
+// thresholds returns the default thresholds overridden by the ones in the config file.
+// It returns nil when the config file has no thresholds, then printers use the defaults.
+func (fc *fileConfig) thresholds() *printer.Thresholds {
+	if fc.Thresholds == nil {
+		return nil
+	}
+
+	t := printer.DefaultThresholds()
+	if fc.Thresholds.RestartsWarning != nil {
+		t.RestartsWarning = *fc.Thresholds.RestartsWarning
+	}
+	if fc.Thresholds.RestartsError != nil {
+		t.RestartsError = *fc.Thresholds.RestartsError
+	}
+	if fc.Thresholds.YoungAge != nil {
+		t.YoungAge = *fc.Thresholds.YoungAge
+	}
+	if fc.Thresholds.UsageWarning != nil {
+		t.UsageWarning = *fc.Thresholds.UsageWarning
+	}
+	if fc.Thresholds.UsageError != nil {
+		t.UsageError = *fc.Thresholds.UsageError
+	}
+
+	return t
+}
+
+// configFilePath returns the path of the config file.
+// KUBECOLOR_CONFIG has priority, otherwise ~/.kube/color.yaml is used.
+func configFilePath() string {
+	if p := getenv(configFileEnv); p != "" {
+		return p
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(home, ".kube", "color.yaml")
+}
+
+// themesDir returns the directory where theme files are looked up.
+// It is the "color-themes" directory next to the config file, e.g. ~/.kube/color-themes.
+func themesDir() string {
+	return filepath.Join(filepath.Dir(configFilePath()), "color-themes")
+}
+
+// readConfigFile reads the config file at path.
+// A missing file is not an error; it returns an empty config instead.
+func readConfigFile(path string) (*fileConfig, error) {
+	fc := &fileConfig{}
+	if path == "" {
+		return fc, nil
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return fc, nil
+		}
+		return nil, fmt.Errorf("read config file %s: %w", path, err)
+	}
+
+	if err := yaml.Unmarshal(b, fc); err != nil {
+		return nil, fmt.Errorf("parse config file %s: %w", path, err)
+	}
+
+	switch fc.Background {
+	case "", "auto", "dark", "light":
+	default:
+		return nil, fmt.Errorf("parse config file %s: background must be auto, dark or light, got %q", path, fc.Background)
+	}
+
+	switch printer.LogFormat(fc.LogFormat) {
+	case "", printer.LogFormatAuto, printer.LogFormatPlain, printer.LogFormatJSON, printer.LogFormatKlog, printer.LogFormatLogfmt:
+	default:
+		return nil, fmt.Errorf("parse config file %s: logFormat must be auto, plain, json, klog or logfmt, got %q", path, fc.LogFormat)
+	}
+
+	for severity := range fc.Statuses {
+		switch severity {
+		case printer.StatusHealthy, printer.StatusWarning, printer.StatusUnhealthy:
+		default:
+			return nil, fmt.Errorf("parse config file %s: the keys of statuses must be healthy, warning or unhealthy, got %q", path, severity)
+		}
+	}
+
+	if t := fc.thresholds(); t != nil {
+		if t.RestartsWarning < 0 || t.RestartsError < t.RestartsWarning {
+			return nil, fmt.Errorf("parse config file %s: thresholds must be 0 <= restartsWarning <= restartsError, got %d and %d", path, t.RestartsWarning, t.RestartsError)
+		}
+		if t.UsageWarning < 0 || t.UsageError < t.UsageWarning {
+			return nil, fmt.Errorf("parse config file %s: thresholds must be 0 <= usageWarning <= usageError, got %d and %d", path, t.UsageWarning, t.UsageError)
+		}
+	}
+
+	return fc, nil
+}
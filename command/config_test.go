@@ -1,19 +1,28 @@
 package command
 
 import (
+	"bytes"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/hidetatz/kubecolor/printer"
 	"github.com/hidetatz/kubecolor/testutil"
 )
 
 func Test_ResolveConfig(t *testing.T) {
 	tests := []struct {
-		name           string
-		args           []string
-		kubectlCommand string
-		expectedArgs   []string
-		expectedConf   *KubecolorConfig
+		name            string
+		args            []string
+		kubectlCommand  string
+		env             map[string]string
+		configFile      string
+		expectedArgs    []string
+		expectedConf    *KubecolorConfig
+		expectedWarning string
+		expectedErr     bool
 	}{
 		{
 			name:         "no config",
@@ -22,6 +31,7 @@ func Test_ResolveConfig(t *testing.T) {
 			expectedConf: &KubecolorConfig{
 				Plain:          false,
 				DarkBackground: true,
+				AutoBackground: true,
 				ForceColor:     false,
 				KubectlCmd:     "kubectl",
 			},
@@ -45,6 +55,7 @@ func Test_ResolveConfig(t *testing.T) {
 			expectedConf: &KubecolorConfig{
 				Plain:          true,
 				DarkBackground: true,
+				AutoBackground: true,
 				ForceColor:     false,
 				KubectlCmd:     "kubectl.1.19",
 			},
@@ -56,6 +67,7 @@ func Test_ResolveConfig(t *testing.T) {
 			expectedConf: &KubecolorConfig{
 				Plain:          false,
 				DarkBackground: true,
+				AutoBackground: true,
 				ForceColor:     false,
 				KubectlCmd:     "oc",
 				UseOcCli:       true,
@@ -69,6 +81,7 @@ func Test_ResolveConfig(t *testing.T) {
 			expectedConf: &KubecolorConfig{
 				Plain:          false,
 				DarkBackground: true,
+				AutoBackground: true,
 				ForceColor:     false,
 				KubectlCmd:     "oc",
 				UseOcCli:       true,
@@ -82,23 +95,419 @@ func Test_ResolveConfig(t *testing.T) {
 			expectedConf: &KubecolorConfig{
 				Plain:          false,
 				DarkBackground: true,
+				AutoBackground: true,
 				ForceColor:     false,
 				KubectlCmd:     "customkubectl",
 				UseOcCli:       false,
 			},
 		},
+		{
+			name: "config file",
+			args: []string{"get", "pods"},
+			configFile: testutil.NewHereDoc(`
+				background: light
+				forceColors: true
+				kubectl: kubectl.1.19
+				theme: solarized
+				logFormat: json
+				statuses:
+				  unhealthy: [Degraded]
+				thresholds:
+				  restartsWarning: 0
+				  youngAge: 10m
+				  usageError: 80
+				topBars: true
+				stableColors: true
+				subcommands:
+				  logs: false
+				  edit: true`),
+			expectedArgs: []string{"get", "pods"},
+			expectedConf: &KubecolorConfig{
+				Plain:          false,
+				DarkBackground: false,
+				ForceColor:     true,
+				KubectlCmd:     "kubectl.1.19",
+				Theme:          "solarized",
+				LogFormat:      printer.LogFormatJSON,
+				Statuses:       printer.NewStatusRegistry(map[printer.StatusSeverity][]string{printer.StatusUnhealthy: {"Degraded"}}),
+				Thresholds:     &printer.Thresholds{RestartsWarning: 0, RestartsError: 10, YoungAge: 10 * time.Minute, UsageWarning: 70, UsageError: 80},
+				TopBars:        true,
+				StableColors:   true,
+				Subcommands:    map[string]bool{"logs": false, "edit": true},
+			},
+		},
+		{
+			name: "flags have priority over config file",
+			args: []string{"get", "pods", "--plain", "--use-oc-cli"},
+			configFile: testutil.NewHereDoc(`
+				background: dark
+				forceColors: true
+				kubectl: kubectl.1.19`),
+			expectedArgs: []string{"get", "pods"},
+			expectedConf: &KubecolorConfig{
+				Plain:          true,
+				DarkBackground: true,
+				ForceColor:     false,
+				KubectlCmd:     "oc",
+				UseOcCli:       true,
+			},
+		},
+		{
+			name: "light-background flag has priority over config file",
+			args: []string{"get", "pods", "--light-background"},
+			configFile: testutil.NewHereDoc(`
+				background: dark
+				plain: true`),
+			expectedArgs: []string{"get", "pods"},
+			expectedConf: &KubecolorConfig{
+				Plain:          true,
+				DarkBackground: false,
+				ForceColor:     false,
+				KubectlCmd:     "kubectl",
+			},
+		},
+		{
+			name:           "KUBECTL_COMMAND has priority over config file",
+			args:           []string{"get", "pods"},
+			kubectlCommand: "customkubectl",
+			configFile:     `kubectl: kubectl.1.19`,
+			expectedArgs:   []string{"get", "pods"},
+			expectedConf: &KubecolorConfig{
+				Plain:          false,
+				DarkBackground: true,
+				AutoBackground: true,
+				ForceColor:     false,
+				KubectlCmd:     "customkubectl",
+			},
+		},
+		{
+			name:         "NO_COLOR",
+			args:         []string{"get", "pods"},
+			env:          map[string]string{"NO_COLOR": "1"},
+			expectedArgs: []string{"get", "pods"},
+			expectedConf: &KubecolorConfig{
+				Plain:          true,
+				DarkBackground: true,
+				AutoBackground: true,
+				KubectlCmd:     "kubectl",
+			},
+		},
+		{
+			name:         "empty NO_COLOR is ignored",
+			args:         []string{"get", "pods"},
+			env:          map[string]string{"NO_COLOR": ""},
+			expectedArgs: []string{"get", "pods"},
+			expectedConf: &KubecolorConfig{
+				DarkBackground: true,
+				AutoBackground: true,
+				KubectlCmd:     "kubectl",
+			},
+		},
+		{
+			name:         "CLICOLOR_FORCE",
+			args:         []string{"get", "pods"},
+			env:          map[string]string{"CLICOLOR_FORCE": "1"},
+			expectedArgs: []string{"get", "pods"},
+			expectedConf: &KubecolorConfig{
+				ForceColor:     true,
+				DarkBackground: true,
+				AutoBackground: true,
+				KubectlCmd:     "kubectl",
+			},
+		},
+		{
+			name:         "CLICOLOR_FORCE=0 is ignored",
+			args:         []string{"get", "pods"},
+			env:          map[string]string{"CLICOLOR_FORCE": "0"},
+			expectedArgs: []string{"get", "pods"},
+			expectedConf: &KubecolorConfig{
+				DarkBackground: true,
+				AutoBackground: true,
+				KubectlCmd:     "kubectl",
+			},
+		},
+		{
+			name:         "CLICOLOR=0",
+			args:         []string{"get", "pods"},
+			env:          map[string]string{"CLICOLOR": "0"},
+			expectedArgs: []string{"get", "pods"},
+			expectedConf: &KubecolorConfig{
+				Plain:          true,
+				DarkBackground: true,
+				AutoBackground: true,
+				KubectlCmd:     "kubectl",
+			},
+		},
+		{
+			name:         "TERM=dumb",
+			args:         []string{"get", "pods"},
+			env:          map[string]string{"TERM": "dumb"},
+			expectedArgs: []string{"get", "pods"},
+			expectedConf: &KubecolorConfig{
+				Plain:          true,
+				DarkBackground: true,
+				AutoBackground: true,
+				KubectlCmd:     "kubectl",
+			},
+		},
+		{
+			name:         "forced colors win over TERM=dumb and CLICOLOR=0",
+			args:         []string{"get", "pods"},
+			env:          map[string]string{"TERM": "dumb", "CLICOLOR": "0", "CLICOLOR_FORCE": "1"},
+			expectedArgs: []string{"get", "pods"},
+			expectedConf: &KubecolorConfig{
+				ForceColor:     true,
+				DarkBackground: true,
+				AutoBackground: true,
+				KubectlCmd:     "kubectl",
+			},
+		},
+		{
+			name:         "force-colors flag wins over NO_COLOR",
+			args:         []string{"get", "pods", "--force-colors"},
+			env:          map[string]string{"NO_COLOR": "1"},
+			expectedArgs: []string{"get", "pods"},
+			expectedConf: &KubecolorConfig{
+				ForceColor:     true,
+				DarkBackground: true,
+				AutoBackground: true,
+				KubectlCmd:     "kubectl",
+			},
+		},
+		{
+			name:         "KUBECOLOR_PLAIN=false wins over NO_COLOR",
+			args:         []string{"get", "pods"},
+			env:          map[string]string{"NO_COLOR": "1", "KUBECOLOR_PLAIN": "false"},
+			expectedArgs: []string{"get", "pods"},
+			expectedConf: &KubecolorConfig{
+				DarkBackground: true,
+				AutoBackground: true,
+				KubectlCmd:     "kubectl",
+			},
+		},
+		{
+			name: "env has priority over config file",
+			args: []string{"get", "pods"},
+			env: map[string]string{
+				"KUBECOLOR_FORCE_COLORS":     "true",
+				"KUBECOLOR_LIGHT_BACKGROUND": "false",
+			},
+			configFile: testutil.NewHereDoc(`
+				background: light
+				plain: true`),
+			expectedArgs: []string{"get", "pods"},
+			expectedConf: &KubecolorConfig{
+				ForceColor:     true,
+				DarkBackground: true,
+				KubectlCmd:     "kubectl",
+			},
+		},
+		{
+			name: "KUBECOLOR_* for every flag",
+			args: []string{"get", "pods"},
+			env: map[string]string{
+				"KUBECOLOR_PLAIN":            "1",
+				"KUBECOLOR_LIGHT_BACKGROUND": "true",
+				"KUBECOLOR_SHOW_VERSION":     "true",
+				"KUBECOLOR_USE_OC_CLI":       "true",
+				"KUBECTL_COMMAND":            "customkubectl",
+			},
+			expectedArgs: []string{"get", "pods"},
+			expectedConf: &KubecolorConfig{
+				Plain:                true,
+				DarkBackground:       false,
+				ShowKubecolorVersion: true,
+				KubectlCmd:           "oc",
+				UseOcCli:             true,
+			},
+		},
+		{
+			name: "invalid KUBECOLOR_* values are ignored",
+			args: []string{"get", "pods"},
+			env: map[string]string{
+				"KUBECOLOR_FORCE_COLORS":     "yes",
+				"KUBECOLOR_LIGHT_BACKGROUND": "light",
+			},
+			expectedArgs: []string{"get", "pods"},
+			expectedConf: &KubecolorConfig{
+				DarkBackground: true,
+				AutoBackground: true,
+				KubectlCmd:     "kubectl",
+			},
+			expectedWarning: "kubecolor: KUBECOLOR_FORCE_COLORS=\"yes\" is ignored, it must be true or false\n" +
+				"kubecolor: KUBECOLOR_LIGHT_BACKGROUND=\"light\" is ignored, it must be true or false\n",
+		},
+		{
+			name:         "KUBECOLOR_VERSION pinning the version to install is not a flag",
+			args:         []string{"get", "pods"},
+			env:          map[string]string{"KUBECOLOR_VERSION": "0.5.0"},
+			expectedArgs: []string{"get", "pods"},
+			expectedConf: &KubecolorConfig{
+				DarkBackground: true,
+				AutoBackground: true,
+				KubectlCmd:     "kubectl",
+			},
+		},
+		{
+			name:         "background is left to the terminal",
+			args:         []string{"get", "pods"},
+			configFile:   `background: auto`,
+			expectedArgs: []string{"get", "pods"},
+			expectedConf: &KubecolorConfig{
+				DarkBackground: true,
+				AutoBackground: true,
+				KubectlCmd:     "kubectl",
+			},
+		},
+		{
+			name:         "config file tells the background",
+			args:         []string{"get", "pods"},
+			configFile:   `background: light`,
+			expectedArgs: []string{"get", "pods"},
+			expectedConf: &KubecolorConfig{
+				DarkBackground: false,
+				KubectlCmd:     "kubectl",
+			},
+		},
+		{
+			name:         "KUBECOLOR_LIGHT_BACKGROUND tells the background",
+			args:         []string{"get", "pods"},
+			env:          map[string]string{"KUBECOLOR_LIGHT_BACKGROUND": "false"},
+			expectedArgs: []string{"get", "pods"},
+			expectedConf: &KubecolorConfig{
+				DarkBackground: true,
+				KubectlCmd:     "kubectl",
+			},
+		},
+		{
+			name:         "invalid background in config file",
+			args:         []string{"get", "pods"},
+			configFile:   `background: gray`,
+			expectedArgs: []string{"get", "pods"},
+			expectedErr:  true,
+		},
+		{
+			name:         "invalid logFormat in config file",
+			args:         []string{"logs", "pod"},
+			configFile:   `logFormat: xml`,
+			expectedArgs: []string{"logs", "pod"},
+			expectedErr:  true,
+		},
+		{
+			name:         "invalid severity of statuses in config file",
+			args:         []string{"get", "pods"},
+			configFile:   `statuses: {critical: [Degraded]}`,
+			expectedArgs: []string{"get", "pods"},
+			expectedErr:  true,
+		},
+		{
+			name:         "invalid thresholds in config file",
+			args:         []string{"get", "pods"},
+			configFile:   `thresholds: {restartsWarning: 20}`,
+			expectedArgs: []string{"get", "pods"},
+			expectedErr:  true,
+		},
+		{
+			name:         "broken config file",
+			args:         []string{"get", "pods"},
+			configFile:   `subcommands: [`,
+			expectedArgs: []string{"get", "pods"},
+			expectedErr:  true,
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
+			// never read the environment of the test runner, e.g. NO_COLOR or TERM=dumb
+			env := map[string]string{}
+			for k, v := range tt.env {
+				env[k] = v
+			}
 			if tt.kubectlCommand != "" {
-				os.Setenv("KUBECTL_COMMAND", tt.kubectlCommand)
-				defer os.Unsetenv("KUBECTL_COMMAND")
+				env["KUBECTL_COMMAND"] = tt.kubectlCommand
+			}
+
+			// never read the config file in the home directory of the test runner
+			configPath := filepath.Join(t.TempDir(), "color.yaml")
+			if tt.configFile != "" {
+				if err := os.WriteFile(configPath, []byte(tt.configFile), 0o600); err != nil {
+					t.Fatal(err)
+				}
 			}
+			env["KUBECOLOR_CONFIG"] = configPath
 
-			args, conf := ResolveConfig(tt.args)
+			getenv = func(key string) string { return env[key] }
+			defer func() { getenv = os.Getenv }()
+
+			var w bytes.Buffer
+			warnings = &w
+			defer func() { warnings = os.Stderr }()
+
+			// the terminal must not be asked while the subcommand is unknown
+			origDetectBackground := detectBackground
+			detectBackground = func() (bool, bool) {
+				t.Error("the terminal is asked its background")
+				return false, false
+			}
+			defer func() { detectBackground = origDetectBackground }()
+
+			args, conf, err := ResolveConfig(tt.args)
+			if tt.expectedErr {
+				if err == nil {
+					t.Fatalf("error is expected but got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
 			testutil.MustEqual(t, tt.expectedArgs, args)
 			testutil.MustEqual(t, tt.expectedConf, conf)
+			testutil.MustEqual(t, tt.expectedWarning, w.String())
+		})
+	}
+}
+
+func Test_resolveBackground(t *testing.T) {
+	tests := []struct {
+		name             string
+		args             string
+		config           *KubecolorConfig
+		isOutputTerminal bool
+		expectedAsked    bool
+		expectedDark     bool
+	}{
+		{"asked when nothing tells the background", "get pods", &KubecolorConfig{DarkBackground: true, AutoBackground: true}, true, true, false},
+		{"not asked when the background is given", "get pods", &KubecolorConfig{DarkBackground: true}, true, false, true},
+		{"not asked when a theme is set", "get pods", &KubecolorConfig{DarkBackground: true, AutoBackground: true, Theme: "solarized"}, true, false, true},
+		{"not asked for plain output", "get pods", &KubecolorConfig{DarkBackground: true, AutoBackground: true, Plain: true}, true, false, true},
+		{"not asked when the output is not a terminal", "get pods", &KubecolorConfig{DarkBackground: true, AutoBackground: true, ForceColor: true}, false, false, true},
+		{"not asked when the subcommand is not colorized", "get pods", &KubecolorConfig{DarkBackground: true, AutoBackground: true, Subcommands: map[string]bool{"get": false}}, true, false, true},
+
+		// the commands reading the keyboard must never get the answer of the terminal or lose the keys typed ahead
+		{"exec -it", "exec -it pod -- sh", &KubecolorConfig{DarkBackground: true, AutoBackground: true}, true, false, true},
+		{"attach", "attach -it pod", &KubecolorConfig{DarkBackground: true, AutoBackground: true}, true, false, true},
+		{"edit", "edit deploy nginx", &KubecolorConfig{DarkBackground: true, AutoBackground: true}, true, false, true},
+		{"port-forward", "port-forward pod/nginx 8080:80", &KubecolorConfig{DarkBackground: true, AutoBackground: true}, true, false, true},
+		{"run -it", "run -it busybox --image busybox -- sh", &KubecolorConfig{DarkBackground: true, AutoBackground: true}, true, false, true},
+		{"debug", "debug -it pod/nginx --image busybox", &KubecolorConfig{DarkBackground: true, AutoBackground: true}, true, false, true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			asked := false
+			origIsOutputTerminal, origDetectBackground := isOutputTerminal, detectBackground
+			isOutputTerminal = func() bool { return tt.isOutputTerminal }
+			detectBackground = func() (bool, bool) {
+				asked = true
+				return false, true // light
+			}
+			defer func() { isOutputTerminal, detectBackground = origIsOutputTerminal, origDetectBackground }()
+
+			shouldColorize, info := ResolveSubcommand(strings.Split(tt.args, " "), tt.config)
+			resolveBackground(tt.config, shouldColorize, info)
+			testutil.MustEqual(t, tt.expectedAsked, asked)
+			testutil.MustEqual(t, tt.expectedDark, tt.config.DarkBackground)
 		})
 	}
 }
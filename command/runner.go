@@ -2,6 +2,7 @@ package command
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -26,28 +27,38 @@ type Printers struct {
 }
 
 // This is defined here to be replaced in test
-var getPrinters = func(subcommandInfo *kubectl.CLICommandInfo, darkBackground bool) *Printers {
+var getPrinters = func(subcommandInfo *kubectl.CLICommandInfo, theme *printer.Theme, config *KubecolorConfig) *Printers {
 	return &Printers{
 		FullColoredPrinter: &printer.KubectlOutputColoredPrinter{
 			SubcommandInfo: subcommandInfo,
-			DarkBackground: darkBackground,
+			Theme:          theme,
 			Recursive:      subcommandInfo.Recursive,
+			LogFormat:      config.LogFormat,
+			Statuses:       config.Statuses,
+			Thresholds:     config.Thresholds,
+			TopBars:        config.TopBars,
+			StableColors:   config.StableColors,
 		},
 		ErrorPrinter: &printer.WithFuncPrinter{
-			Fn: func(line string) color.Color {
+			Fn: func(line string) color.Styler {
 				if strings.HasPrefix(strings.ToLower(line), "error") {
-					return color.Red
+					return theme.Error
 				}
 
-				return color.Yellow
+				return theme.Warning
 			},
 		},
 	}
 }
 
 func Run(args []string, version string) error {
-	args, config := ResolveConfig(args)
+	args, config, err := ResolveConfig(args)
+	if err != nil {
+		return err
+	}
+
 	shouldColorize, subcommandInfo := ResolveSubcommand(args, config)
+	shouldColorizeErr := ResolveErrorColorization(args, config)
 
 	if config.ShowKubecolorVersion {
 		fmt.Fprintf(os.Stdout, "%s\n", version)
@@ -59,70 +70,97 @@ func Run(args []string, version string) error {
 
 	// when should not colorize, just run command and return
 	// TODO: right now, krew is unsupported by kubecolor but it should be.
-	if !shouldColorize {
+	if !shouldColorize && !shouldColorizeErr {
 		cmd.Stdout = Stdout
 		cmd.Stderr = Stderr
 		if err := cmd.Start(); err != nil {
 			return err
 		}
 
-		// inherit the kubectl exit code
-		if err := cmd.Wait(); err != nil {
-			return fmt.Errorf("%w", &KubectlError{ExitCode: cmd.ProcessState.ExitCode()})
+		return waitKubectl(cmd)
+	}
+
+	// when colorize, capture stdout and/or stderr then colorize it.
+	// The stream which should not be colorized is passed through as is.
+	var cmdOut, cmdErr io.Reader
+	if shouldColorize {
+		if cmdOut, err = cmd.StdoutPipe(); err != nil {
+			return err
 		}
-		return nil
+	} else {
+		cmd.Stdout = Stdout
 	}
 
-	// when colorize, capture stdout and err then colorize it
-	cmdOut, err := cmd.StdoutPipe()
-	if err != nil {
-		return err
+	if shouldColorizeErr {
+		if cmdErr, err = cmd.StderrPipe(); err != nil {
+			return err
+		}
+	} else {
+		cmd.Stderr = Stderr
 	}
 
-	cmdErr, err := cmd.StderrPipe()
+	resolveBackground(config, shouldColorize, subcommandInfo)
+	theme, err := resolveTheme(config)
 	if err != nil {
 		return err
 	}
 
-	// make buffer to be used in defer recover()
-	buff := new(bytes.Buffer)
-	outReader := io.TeeReader(cmdOut, buff)
-	errReader := io.TeeReader(cmdErr, buff)
-
 	if err := cmd.Start(); err != nil {
 		return err
 	}
 
-	printers := getPrinters(subcommandInfo, config.DarkBackground)
+	// themes can use 256 or 24-bit colors; they fall back to the nearest ones the terminal can show
+	color.SetLevel(color.DetectLevel(getenv))
+	printers := getPrinters(subcommandInfo, theme, config)
 
 	wg := &sync.WaitGroup{}
 
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		defer func() {
-			if r := recover(); r != nil {
-				fmt.Fprintf(os.Stdout, buff.String())
-			}
-		}()
+	if shouldColorize {
+		// make buffer to be used in defer recover()
+		buff := new(bytes.Buffer)
+		outReader := io.TeeReader(cmdOut, buff)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					fmt.Fprint(os.Stdout, buff.String())
+				}
+			}()
 
-		// This can panic when kubecolor has bug, so recover in defer
-		printers.FullColoredPrinter.Print(outReader, Stdout)
-	}()
+			// This can panic when kubecolor has bug, so recover in defer
+			printers.FullColoredPrinter.Print(outReader, Stdout)
+		}()
+	}
 
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		// This will unlikely panic
-		printers.ErrorPrinter.Print(errReader, Stderr)
-	}()
+	if shouldColorizeErr {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// This will unlikely panic
+			printers.ErrorPrinter.Print(cmdErr, Stderr)
+		}()
+	}
 
 	wg.Wait()
 
-	// inherit the kubectl exit code
-	if err := cmd.Wait(); err != nil {
-		return fmt.Errorf("%w", &KubectlError{ExitCode: cmd.ProcessState.ExitCode()})
+	return waitKubectl(cmd)
+}
+
+// waitKubectl waits for kubectl to exit and inherits its exit code,
+// e.g. kubectl diff exits with 1 when there are differences.
+func waitKubectl(cmd *exec.Cmd) error {
+	err := cmd.Wait()
+	if err == nil {
+		return nil
+	}
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return fmt.Errorf("%w", &KubectlError{ExitCode: exitErr.ExitCode()})
 	}
 
-	return nil
+	// e.g. copying stdin failed, where kubectl may not have exited with an error
+	return err
 }
@@ -9,11 +9,31 @@ import (
 )
 
 // mocked in unit tests
-var isOutputTerminal = func() bool {
-	return isatty.IsTerminal(os.Stdout.Fd()) || isatty.IsCygwinTerminal(os.Stdout.Fd())
-}
+var (
+	isOutputTerminal = func() bool {
+		return isatty.IsTerminal(os.Stdout.Fd()) || isatty.IsCygwinTerminal(os.Stdout.Fd())
+	}
+	isErrorTerminal = func() bool {
+		return isatty.IsTerminal(os.Stderr.Fd()) || isatty.IsCygwinTerminal(os.Stderr.Fd())
+	}
+)
 
+// ResolveSubcommand inspects args and returns whether the standard output of the command should be colorized.
 func ResolveSubcommand(args []string, config *KubecolorConfig) (bool, *kubectl.CLICommandInfo) {
+	return resolveColorization(args, config, isOutputTerminal)
+}
+
+// ResolveErrorColorization returns whether the standard error of the command should be colorized.
+// It is decided apart from the standard output because they can be redirected separately,
+// e.g. "kubecolor get pods 2>err.log".
+func ResolveErrorColorization(args []string, config *KubecolorConfig) bool {
+	shouldColorize, _ := resolveColorization(args, config, isErrorTerminal)
+	return shouldColorize
+}
+
+// resolveColorization decides whether the output stream should be colorized.
+// isTerminal reports whether the stream is a terminal.
+func resolveColorization(args []string, config *KubecolorConfig, isTerminal func() bool) (bool, *kubectl.CLICommandInfo) {
 	// subcommandFound becomes false when subcommand is not found; e.g. "kubecolor --help"
 	subcommandInfo, subcommandFound := kubectl.InspectCLICommandInfo(args)
 
@@ -38,35 +58,58 @@ func ResolveSubcommand(args []string, config *KubecolorConfig) (bool, *kubectl.C
 		return true, subcommandInfo
 	}
 
-	// when the command output tty is not standard output, shouldColorize depends on --force-colors flag.
+	// the config file can turn colorizing on or off per subcommand
+	enabled, toggled := subcommandToggle(subcommandInfo.Subcommand, config.Subcommands)
+	if toggled && !enabled {
+		return false, subcommandInfo
+	}
+
+	// when the output stream is not a terminal, shouldColorize depends on --force-colors flag.
 	// For example, if the command is run in a shellscript, it should not colorize. (e.g. in "kubectl completion bash")
 	// However, if user wants colored output even if the out is not tty (e.g. kubecolor get xx | grep yy)
 	// it colorizes the output based on --force-colors.
-	if !isOutputTerminal() {
+	if !isTerminal() {
 		return config.ForceColor, subcommandInfo
 	}
 
 	// else, when the given subcommand is supported, then we colorize it
-	return subcommandFound && isColoringSupported(subcommandInfo.Subcommand), subcommandInfo
+	return subcommandFound && (toggled || isColoringSupported(subcommandInfo)), subcommandInfo
 }
 
-func isColoringSupported(sc kubectl.CLICommand) bool {
+// subcommandToggle looks up the per-subcommand setting given by the user.
+// found is false when the user didn't configure the subcommand.
+func subcommandToggle(sc kubectl.CLICommand, toggles map[string]bool) (enabled, found bool) {
+	for name, enabled := range toggles {
+		if c, ok := kubectl.InspectCLICommand(name); ok && c == sc {
+			return enabled, true
+		}
+	}
+
+	return false, false
+}
+
+func isColoringSupported(info *kubectl.CLICommandInfo) bool {
 	// when you add something here, it won't be colorized
 	unsupported := []kubectl.CLICommand{
-		kubectl.Create,
 		kubectl.Debug,
-		kubectl.Delete,
 		kubectl.Edit,
 		kubectl.Attach,
-		kubectl.Replace,
 		kubectl.Completion,
 		kubectl.Exec,
 		kubectl.Proxy,
 		kubectl.Plugin,
 		kubectl.Wait,
-		kubectl.Run,
 		kubectl.Ctx,
 		kubectl.Ns,
+	}
+
+	// these are colorized only when they just print the resources,
+	// e.g. "kubectl create deployment nginx --image=nginx --dry-run=client -o yaml"
+	dryRunOnly := []kubectl.CLICommand{
+		kubectl.Create,
+		kubectl.Delete,
+		kubectl.Replace,
+		kubectl.Run,
 		// oc commands
 		kubectl.NewProject,
 		kubectl.NewApp,
@@ -74,10 +117,16 @@ func isColoringSupported(sc kubectl.CLICommand) bool {
 	}
 
 	for _, u := range unsupported {
-		if sc == u {
+		if info.Subcommand == u {
 			return false
 		}
 	}
 
+	for _, d := range dryRunOnly {
+		if info.Subcommand == d {
+			return info.DryRun && info.FormatOption != kubectl.None
+		}
+	}
+
 	return true
 }
@@ -27,7 +27,7 @@ func Test_ResolveSubcommand(t *testing.T) {
 				KubectlCmd:     "kubectl",
 			},
 			expectedShouldColorize: true,
-			expectedInfo:           &kubectl.CLICommandInfo{Subcommand: kubectl.Get},
+			expectedInfo:           &kubectl.CLICommandInfo{Subcommand: kubectl.Get, Args: []string{"get", "pods"}},
 		},
 		{
 			name:             "when plain, it won't colorize",
@@ -40,7 +40,7 @@ func Test_ResolveSubcommand(t *testing.T) {
 				KubectlCmd:     "kubectl",
 			},
 			expectedShouldColorize: false,
-			expectedInfo:           &kubectl.CLICommandInfo{Subcommand: kubectl.Get},
+			expectedInfo:           &kubectl.CLICommandInfo{Subcommand: kubectl.Get, Args: []string{"get", "pods"}},
 		},
 		{
 			name:             "when help, it will colorize",
@@ -53,7 +53,7 @@ func Test_ResolveSubcommand(t *testing.T) {
 				KubectlCmd:     "kubectl",
 			},
 			expectedShouldColorize: true,
-			expectedInfo:           &kubectl.CLICommandInfo{Subcommand: kubectl.Get, Help: true},
+			expectedInfo:           &kubectl.CLICommandInfo{Subcommand: kubectl.Get, Help: true, Args: []string{"get", "pods", "-h"}},
 		},
 		{
 			name:             "when both plain and force, plain is chosen",
@@ -66,7 +66,7 @@ func Test_ResolveSubcommand(t *testing.T) {
 				KubectlCmd:     "kubectl",
 			},
 			expectedShouldColorize: false,
-			expectedInfo:           &kubectl.CLICommandInfo{Subcommand: kubectl.Get},
+			expectedInfo:           &kubectl.CLICommandInfo{Subcommand: kubectl.Get, Args: []string{"get", "pods"}},
 		},
 		{
 			name:             "when no subcommand is found, it becomes help",
@@ -79,7 +79,7 @@ func Test_ResolveSubcommand(t *testing.T) {
 				KubectlCmd:     "kubectl",
 			},
 			expectedShouldColorize: true,
-			expectedInfo:           &kubectl.CLICommandInfo{Help: true},
+			expectedInfo:           &kubectl.CLICommandInfo{Help: true, Args: []string{}},
 		},
 		{
 			name:             "when the internal argument is found, it won't colorize",
@@ -92,7 +92,8 @@ func Test_ResolveSubcommand(t *testing.T) {
 				KubectlCmd:     "kubectl",
 			},
 			expectedShouldColorize: false,
-			expectedInfo:           &kubectl.CLICommandInfo{Subcommand: kubectl.Get},
+			// "get" is an argument of __completeNoDesc, not the subcommand
+			expectedInfo: &kubectl.CLICommandInfo{Args: []string{"__completeNoDesc", "get", "pods"}},
 		},
 		{
 			name:             "when not tty, it won't colorize",
@@ -105,7 +106,7 @@ func Test_ResolveSubcommand(t *testing.T) {
 				KubectlCmd:     "kubectl",
 			},
 			expectedShouldColorize: false,
-			expectedInfo:           &kubectl.CLICommandInfo{Subcommand: kubectl.Get},
+			expectedInfo:           &kubectl.CLICommandInfo{Subcommand: kubectl.Get, Args: []string{"get", "pods"}},
 		},
 		{
 			name:             "even if not tty, if force, it colorizes",
@@ -118,63 +119,126 @@ func Test_ResolveSubcommand(t *testing.T) {
 				KubectlCmd:     "kubectl",
 			},
 			expectedShouldColorize: true,
-			expectedInfo:           &kubectl.CLICommandInfo{Subcommand: kubectl.Get},
+			expectedInfo:           &kubectl.CLICommandInfo{Subcommand: kubectl.Get, Args: []string{"get", "pods"}},
 		},
 		{
-			name:             "kubectl edit is unsupported",
-			args:             []string{"edit", "deployment"},
-			isOutputTerminal: func() bool { return true },
-			conf:             &KubecolorConfig{},
+			name:                   "kubectl edit is unsupported",
+			args:                   []string{"edit", "deployment"},
+			isOutputTerminal:       func() bool { return true },
+			conf:                   &KubecolorConfig{},
 			expectedShouldColorize: false,
-			expectedInfo:           &kubectl.CLICommandInfo{Subcommand: kubectl.Edit},
+			expectedInfo:           &kubectl.CLICommandInfo{Subcommand: kubectl.Edit, Args: []string{"edit", "deployment"}},
 		},
 		{
-			name:             "oc projects is supported",
-			args:             []string{"projects"},
-			isOutputTerminal: func() bool { return true },
-			conf:             &KubecolorConfig{},
+			name:                   "oc projects is supported",
+			args:                   []string{"projects"},
+			isOutputTerminal:       func() bool { return true },
+			conf:                   &KubecolorConfig{},
 			expectedShouldColorize: true,
-			expectedInfo:           &kubectl.CLICommandInfo{Subcommand: kubectl.Projects},
+			expectedInfo:           &kubectl.CLICommandInfo{Subcommand: kubectl.Projects, Args: []string{"projects"}},
 		},
 		{
-			name:             "oc status is supported",
-			args:             []string{"status"},
-			isOutputTerminal: func() bool { return true },
-			conf:             &KubecolorConfig{},
+			name:                   "oc status is supported",
+			args:                   []string{"status"},
+			isOutputTerminal:       func() bool { return true },
+			conf:                   &KubecolorConfig{},
 			expectedShouldColorize: true,
-			expectedInfo:           &kubectl.CLICommandInfo{Subcommand: kubectl.Status},
+			expectedInfo:           &kubectl.CLICommandInfo{Subcommand: kubectl.Status, Args: []string{"status"}},
 		},
 		{
-			name:             "oc new-project is unsupported",
-			args:             []string{"new-project", "myproject"},
-			isOutputTerminal: func() bool { return true },
-			conf:             &KubecolorConfig{},
+			name:                   "oc new-project is unsupported",
+			args:                   []string{"new-project", "myproject"},
+			isOutputTerminal:       func() bool { return true },
+			conf:                   &KubecolorConfig{},
 			expectedShouldColorize: false,
-			expectedInfo:           &kubectl.CLICommandInfo{Subcommand: kubectl.NewProject},
+			expectedInfo:           &kubectl.CLICommandInfo{Subcommand: kubectl.NewProject, Args: []string{"new-project", "myproject"}},
 		},
 		{
-			name:             "oc new-app is unsupported",
-			args:             []string{"new-app", "nginx"},
-			isOutputTerminal: func() bool { return true },
-			conf:             &KubecolorConfig{},
+			name:                   "oc new-app is unsupported",
+			args:                   []string{"new-app", "nginx"},
+			isOutputTerminal:       func() bool { return true },
+			conf:                   &KubecolorConfig{},
 			expectedShouldColorize: false,
-			expectedInfo:           &kubectl.CLICommandInfo{Subcommand: kubectl.NewApp},
+			expectedInfo:           &kubectl.CLICommandInfo{Subcommand: kubectl.NewApp, Args: []string{"new-app", "nginx"}},
 		},
 		{
-			name:             "oc routes is supported",
-			args:             []string{"routes"},
-			isOutputTerminal: func() bool { return true },
-			conf:             &KubecolorConfig{},
+			name:                   "oc routes is supported",
+			args:                   []string{"routes"},
+			isOutputTerminal:       func() bool { return true },
+			conf:                   &KubecolorConfig{},
+			expectedShouldColorize: true,
+			expectedInfo:           &kubectl.CLICommandInfo{Subcommand: kubectl.Routes, Args: []string{"routes"}},
+		},
+		{
+			name:                   "oc policy is unsupported",
+			args:                   []string{"policy", "add-role-to-user", "edit", "user1"},
+			isOutputTerminal:       func() bool { return true },
+			conf:                   &KubecolorConfig{},
+			expectedShouldColorize: false,
+			expectedInfo:           &kubectl.CLICommandInfo{Subcommand: kubectl.Policy, Args: []string{"policy", "add-role-to-user", "edit", "user1"}},
+		},
+		{
+			name:                   "kubectl create is unsupported",
+			args:                   []string{"create", "deployment", "nginx", "--image=nginx"},
+			isOutputTerminal:       func() bool { return true },
+			conf:                   &KubecolorConfig{},
+			expectedShouldColorize: false,
+			expectedInfo: &kubectl.CLICommandInfo{
+				Subcommand:     kubectl.Create,
+				SubcommandPath: []string{"deployment"},
+				Args:           []string{"create", "deployment", "nginx", "--image=nginx"},
+			},
+		},
+		{
+			name:                   "kubectl create with --dry-run and -o is supported",
+			args:                   []string{"create", "deployment", "nginx", "--image=nginx", "--dry-run=client", "-o", "yaml"},
+			isOutputTerminal:       func() bool { return true },
+			conf:                   &KubecolorConfig{},
 			expectedShouldColorize: true,
-			expectedInfo:           &kubectl.CLICommandInfo{Subcommand: kubectl.Routes},
+			expectedInfo: &kubectl.CLICommandInfo{
+				Subcommand:     kubectl.Create,
+				SubcommandPath: []string{"deployment"},
+				FormatOption:   kubectl.Yaml,
+				DryRun:         true,
+				Args:           []string{"create", "deployment", "nginx", "--image=nginx", "--dry-run=client", "-o", "yaml"},
+			},
+		},
+		{
+			name:                   "kubectl create with -o but without --dry-run is unsupported",
+			args:                   []string{"create", "-f", "pod.yaml", "-o", "yaml"},
+			isOutputTerminal:       func() bool { return true },
+			conf:                   &KubecolorConfig{},
+			expectedShouldColorize: false,
+			expectedInfo:           &kubectl.CLICommandInfo{Subcommand: kubectl.Create, FormatOption: kubectl.Yaml, Args: []string{"create", "-f", "pod.yaml", "-o", "yaml"}},
+		},
+		{
+			name:                   "kubectl edit is unsupported even with --dry-run and -o",
+			args:                   []string{"edit", "deployment", "--dry-run", "-o", "json"},
+			isOutputTerminal:       func() bool { return true },
+			conf:                   &KubecolorConfig{},
+			expectedShouldColorize: false,
+			expectedInfo:           &kubectl.CLICommandInfo{Subcommand: kubectl.Edit, FormatOption: kubectl.Json, DryRun: true, Args: []string{"edit", "deployment", "--dry-run", "-o", "json"}},
 		},
 		{
-			name:             "oc policy is unsupported",
-			args:             []string{"policy", "add-role-to-user", "edit", "user1"},
+			name:             "subcommand can be disabled by config",
+			args:             []string{"get", "pods"},
 			isOutputTerminal: func() bool { return true },
-			conf:             &KubecolorConfig{},
+			conf: &KubecolorConfig{
+				ForceColor:  true,
+				Subcommands: map[string]bool{"get": false},
+			},
 			expectedShouldColorize: false,
-			expectedInfo:           &kubectl.CLICommandInfo{Subcommand: kubectl.Policy},
+			expectedInfo:           &kubectl.CLICommandInfo{Subcommand: kubectl.Get, Args: []string{"get", "pods"}},
+		},
+		{
+			name:             "unsupported subcommand can be enabled by config",
+			args:             []string{"edit", "deployment"},
+			isOutputTerminal: func() bool { return true },
+			conf: &KubecolorConfig{
+				Subcommands: map[string]bool{"edit": true},
+			},
+			expectedShouldColorize: true,
+			expectedInfo:           &kubectl.CLICommandInfo{Subcommand: kubectl.Edit, Args: []string{"edit", "deployment"}},
 		},
 		{
 			name:             "when the subcommand is just -h (help), it will colorize",
@@ -187,7 +251,7 @@ func Test_ResolveSubcommand(t *testing.T) {
 				KubectlCmd:     "kubectl",
 			},
 			expectedShouldColorize: true,
-			expectedInfo:           &kubectl.CLICommandInfo{Help: true},
+			expectedInfo:           &kubectl.CLICommandInfo{Help: true, Args: []string{"-h"}},
 		},
 	}
 	for _, tt := range tests {
@@ -200,3 +264,60 @@ func Test_ResolveSubcommand(t *testing.T) {
 		})
 	}
 }
+
+func Test_ResolveErrorColorization(t *testing.T) {
+	tests := []struct {
+		name                   string
+		args                   []string
+		conf                   *KubecolorConfig
+		isOutputTerminal       bool
+		isErrorTerminal        bool
+		expectedShouldColorize bool
+	}{
+		{
+			name:                   "stderr is a terminal",
+			args:                   []string{"get", "pods"},
+			conf:                   &KubecolorConfig{},
+			isErrorTerminal:        true,
+			expectedShouldColorize: true,
+		},
+		{
+			name:                   "stderr is redirected even if stdout is a terminal",
+			args:                   []string{"get", "pods"},
+			conf:                   &KubecolorConfig{},
+			isOutputTerminal:       true,
+			isErrorTerminal:        false,
+			expectedShouldColorize: false,
+		},
+		{
+			name:                   "stderr is redirected but colors are forced",
+			args:                   []string{"get", "pods"},
+			conf:                   &KubecolorConfig{ForceColor: true},
+			isErrorTerminal:        false,
+			expectedShouldColorize: true,
+		},
+		{
+			name:                   "plain",
+			args:                   []string{"get", "pods"},
+			conf:                   &KubecolorConfig{Plain: true},
+			isErrorTerminal:        true,
+			expectedShouldColorize: false,
+		},
+		{
+			name:                   "unsupported subcommand",
+			args:                   []string{"exec", "-it", "pod", "--", "sh"},
+			conf:                   &KubecolorConfig{},
+			isErrorTerminal:        true,
+			expectedShouldColorize: false,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			isOutputTerminal = func() bool { return tt.isOutputTerminal }
+			isErrorTerminal = func() bool { return tt.isErrorTerminal }
+			shouldColorize := ResolveErrorColorization(tt.args, tt.conf)
+			testutil.MustEqual(t, tt.expectedShouldColorize, shouldColorize)
+		})
+	}
+}
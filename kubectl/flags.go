@@ -0,0 +1,259 @@
+package kubectl
+
+// globalValueFlags are the global flags of kubectl and oc which take a value.
+// They can be given before the subcommand, e.g. "kubectl -n kube-system get pods".
+// Flags which are not listed in this file are treated as boolean ones;
+// their value must be given with "=" like "--dry-run=server".
+var globalValueFlags = flagSet(
+	"--as",
+	"--as-group",
+	"--as-uid",
+	"--cache-dir",
+	"--certificate-authority",
+	"--client-certificate",
+	"--client-key",
+	"--cluster",
+	"--context",
+	"--kubeconfig",
+	"--log-backtrace-at",
+	"--log-dir",
+	"--log-file",
+	"--log-file-max-size",
+	"--log-flush-frequency",
+	"-n", "--namespace",
+	"--password",
+	"--profile",
+	"--profile-output",
+	"--request-timeout",
+	"-s", "--server",
+	"--stderrthreshold",
+	"--tls-server-name",
+	"--token",
+	"--user",
+	"--username",
+	"-v", "--v",
+	"--vmodule",
+)
+
+// valueFlags are the flags which take a value in most subcommands.
+var valueFlags = flagSet(
+	"--api-group",
+	"--api-version",
+	"-c", "--container",
+	"--chunk-size",
+	"--field-manager",
+	"--field-selector",
+	"-f", "--filename",
+	"--for",
+	"--grace-period",
+	"--image",
+	"-k", "--kustomize",
+	"-L", "--label-columns",
+	"--limits",
+	"--name",
+	"-o", "--output",
+	"--overrides",
+	"--pod-running-timeout",
+	"--port",
+	"--prune-allowlist",
+	"--prune-whitelist",
+	"--replicas",
+	"--requests",
+	"--resource-version",
+	"-l", "--selector",
+	"--sort-by",
+	"--subresource",
+	"--template",
+	"--timeout",
+	"--type",
+)
+
+// subcommandFlags are the flags which take a value only in the subcommand.
+// A false entry marks a boolean flag whose name takes a value elsewhere, e.g. "logs -f".
+var subcommandFlags = map[CLICommand]map[string]bool{
+	Autoscale: {
+		"--cpu-percent": true,
+		"--max":         true,
+		"--min":         true,
+	},
+	ClusterInfo: {
+		"--output-directory": true,
+	},
+	Create: {
+		"--aggregation-rule":  true,
+		"--annotation":        true,
+		"--audience":          true,
+		"--cert":              true,
+		"--class":             true,
+		"--clusterip":         true,
+		"--clusterrole":       true,
+		"--default-backend":   true,
+		"--description":       true,
+		"--docker-email":      true,
+		"--docker-password":   true,
+		"--docker-server":     true,
+		"--docker-username":   true,
+		"--duration":          true,
+		"--external-name":     true,
+		"--from":              true,
+		"--from-env-file":     true,
+		"--from-file":         true,
+		"--from-literal":      true,
+		"--group":             true,
+		"--hard":              true,
+		"--key":               true,
+		"--max-unavailable":   true,
+		"--min-available":     true,
+		"--node-port":         true,
+		"--non-resource-url":  true,
+		"--preemption-policy": true,
+		"--raw":               true,
+		"--resource":          true,
+		"--resource-name":     true,
+		"--role":              true,
+		"--rule":              true,
+		"--schedule":          true,
+		"--scopes":            true,
+		"--serviceaccount":    true,
+		"--tcp":               true,
+		"--user":              true,
+		"--value":             true,
+		"--verb":              true,
+	},
+	Debug: {
+		"-e": true, "--env": true,
+		"--copy-to":           true,
+		"--custom":            true,
+		"--image-pull-policy": true,
+		"--profile":           true,
+		"--set-image":         true,
+		"--target":            true,
+	},
+	Delete: {
+		"--raw": true,
+	},
+	Drain: {
+		"--pod-selector":                 true,
+		"--skip-wait-for-delete-timeout": true,
+	},
+	Expose: {
+		"--cluster-ip":       true,
+		"--external-ip":      true,
+		"--labels":           true,
+		"--load-balancer-ip": true,
+		"--protocol":         true,
+		"--session-affinity": true,
+		"--target-port":      true,
+	},
+	// "--raw" is a boolean in "config view --raw"
+	Get: {
+		"--raw": true,
+	},
+	Logs: {
+		"-f": false, "--follow": false,
+		"-p": false, "--previous": false,
+		"--limit-bytes":      true,
+		"--max-log-requests": true,
+		"--since":            true,
+		"--since-time":       true,
+		"--tail":             true,
+	},
+	Patch: {
+		"-p": true, "--patch": true,
+		"--patch-file": true,
+	},
+	PortForward: {
+		"--address": true,
+	},
+	Proxy: {
+		"-p": true, "--port": true,
+		"-w": true, "--www": true,
+		"-P": true, "--www-prefix": true,
+		"-u": true, "--unix-socket": true,
+		"--accept-hosts":   true,
+		"--accept-paths":   true,
+		"--address":        true,
+		"--api-prefix":     true,
+		"--reject-methods": true,
+		"--reject-paths":   true,
+		"--keepalive":      true,
+	},
+	Replace: {
+		"--raw": true,
+	},
+	Rollout: {
+		"--revision":    true,
+		"--to-revision": true,
+	},
+	Run: {
+		"--env":               true,
+		"--image-pull-policy": true,
+		"--labels":            true,
+		"--restart":           true,
+		"--annotations":       true,
+		"--serviceaccount":    true,
+	},
+	Scale: {
+		"--current-replicas": true,
+	},
+	Set: {
+		"-c": true, "--containers": true,
+		"-e": true, "--env": true,
+		"--from":           true,
+		"--keys":           true,
+		"--prefix":         true,
+		"--group":          true,
+		"--serviceaccount": true,
+	},
+	// oc commands
+	NewApp: {
+		"-e": true, "--env": true,
+		"-i": true, "--image-stream": true,
+		"-l": true, "--labels": true,
+		"-p": true, "--param": true,
+		"--build-env":     true,
+		"--context-dir":   true,
+		"--docker-image":  true,
+		"--env-file":      true,
+		"--param-file":    true,
+		"--source-secret": true,
+		"--strategy":      true,
+	},
+	NewProject: {
+		"--description":  true,
+		"--display-name": true,
+	},
+	Policy: {
+		"-z": true, "--serviceaccount": true,
+		"--role-namespace":   true,
+		"--rolebinding-name": true,
+	},
+	Status: {
+		"-v": false, "--verbose": false,
+	},
+}
+
+// takesValue returns true when the flag takes a value in the subcommand.
+// sc is zero when the flag is given before the subcommand.
+func takesValue(sc CLICommand, name string) bool {
+	if sc != 0 {
+		if v, ok := subcommandFlags[sc][name]; ok {
+			return v
+		}
+
+		if valueFlags[name] {
+			return true
+		}
+	}
+
+	return globalValueFlags[name]
+}
+
+func flagSet(names ...string) map[string]bool {
+	set := make(map[string]bool, len(names))
+	for _, n := range names {
+		set[n] = true
+	}
+
+	return set
+}
@@ -0,0 +1,156 @@
+package kubectl
+
+import (
+	"strconv"
+	"strings"
+)
+
+// parsedFlag is a flag found in the command line.
+type parsedFlag struct {
+	name string // e.g. "-o", "--output"
+	// value is the value of the flag. Boolean flags have it only when it's given with "=" like "--watch=false".
+	value    string
+	hasValue bool
+}
+
+// parsedArgs is kubectl command line split into the subcommand, positional args and flags.
+type parsedArgs struct {
+	subcommand      CLICommand
+	subcommandFound bool
+	// subcommandPath is the sub-subcommands after the subcommand, e.g. ["status"] for "rollout status"
+	subcommandPath []string
+
+	// positionals are the positional args except the subcommand, e.g. ["pods", "nginx"] for "get pods nginx"
+	positionals []string
+	flags       []parsedFlag
+
+	// afterDoubleDash are the args after "--". They are for another command (e.g. "exec pod -- ls -l")
+	// so kubecolor must not interpret them.
+	afterDoubleDash []string
+}
+
+// parseArgs parses args in the same way as kubectl does.
+// The subcommand is the first positional arg; flags before it are global ones.
+func parseArgs(args []string) *parsedArgs {
+	p := &parsedArgs{}
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--":
+			p.afterDoubleDash = args[i+1:]
+			return p
+		case strings.HasPrefix(arg, "--"):
+			name, value, hasValue := strings.Cut(arg, "=")
+			if !hasValue && takesValue(p.subcommand, name) && i+1 < len(args) {
+				i++
+				value, hasValue = args[i], true
+			}
+			p.flags = append(p.flags, parsedFlag{name: name, value: value, hasValue: hasValue})
+		case strings.HasPrefix(arg, "-") && arg != "-": // "-" alone means stdin
+			i = p.parseShorthands(args, i)
+		case !p.subcommandFound && len(p.positionals) == 0:
+			if sc, ok := InspectCLICommand(arg); ok {
+				p.subcommand, p.subcommandFound = sc, true
+				continue
+			}
+			p.positionals = append(p.positionals, arg)
+		case len(p.positionals) == 0 && p.isSubSubcommand(arg):
+			p.subcommandPath = append(p.subcommandPath, arg)
+		default:
+			p.positionals = append(p.positionals, arg)
+		}
+	}
+
+	return p
+}
+
+// isSubSubcommand returns true when arg is a sub-subcommand of the command path parsed so far.
+func (p *parsedArgs) isSubSubcommand(arg string) bool {
+	if !p.subcommandFound {
+		return false
+	}
+
+	path := strings.Join(append([]string{p.subcommand.String()}, p.subcommandPath...), " ")
+	for _, sub := range subSubcommands[path] {
+		if arg == sub {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseShorthands parses args[i] which is one or more shorthand flags like "-it", "-oyaml" or "-o=yaml",
+// then returns the index of the last arg it consumed.
+func (p *parsedArgs) parseShorthands(args []string, i int) int {
+	shorthands := args[i][1:]
+	for j := 0; j < len(shorthands); j++ {
+		name := "-" + shorthands[j:j+1]
+		rest := shorthands[j+1:]
+
+		if strings.HasPrefix(rest, "=") {
+			p.flags = append(p.flags, parsedFlag{name: name, value: rest[1:], hasValue: true})
+			return i
+		}
+
+		if !takesValue(p.subcommand, name) {
+			p.flags = append(p.flags, parsedFlag{name: name})
+			continue
+		}
+
+		switch {
+		case rest != "": // e.g. "-oyaml"
+			p.flags = append(p.flags, parsedFlag{name: name, value: rest, hasValue: true})
+		case i+1 < len(args): // e.g. "-o yaml"
+			i++
+			p.flags = append(p.flags, parsedFlag{name: name, value: args[i], hasValue: true})
+		default:
+			p.flags = append(p.flags, parsedFlag{name: name})
+		}
+		return i
+	}
+
+	return i
+}
+
+// lastFlag returns the flag given last with one of the names, as kubectl takes the last one.
+func (p *parsedArgs) lastFlag(names ...string) (parsedFlag, bool) {
+	for i := len(p.flags) - 1; i >= 0; i-- {
+		for _, name := range names {
+			if p.flags[i].name == name {
+				return p.flags[i], true
+			}
+		}
+	}
+
+	return parsedFlag{}, false
+}
+
+// allFlags returns all the flags given with one of the names in the given order, for the flags which can be repeated.
+func (p *parsedArgs) allFlags(names ...string) []parsedFlag {
+	flags := []parsedFlag{}
+	for _, f := range p.flags {
+		for _, name := range names {
+			if f.name == name {
+				flags = append(flags, f)
+			}
+		}
+	}
+
+	return flags
+}
+
+// boolFlag returns true when the boolean flag is on, e.g. "--watch" or "--watch=true".
+func (p *parsedArgs) boolFlag(names ...string) bool {
+	f, ok := p.lastFlag(names...)
+	if !ok || takesValue(p.subcommand, f.name) {
+		return false
+	}
+
+	if !f.hasValue {
+		return true
+	}
+
+	b, err := strconv.ParseBool(f.value)
+	return err == nil && b
+}
@@ -0,0 +1,308 @@
+package kubectl
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestInspectCLICommandInfo_GlobalFlags(t *testing.T) {
+	tests := []struct {
+		args       string
+		expected   *CLICommandInfo
+		expectedOK bool
+	}{
+		// flag values which look like subcommands
+		{"-n get describe pod x", &CLICommandInfo{Subcommand: Describe}, true},
+		{"--namespace get describe pod x", &CLICommandInfo{Subcommand: Describe}, true},
+		{"--namespace=get describe pod x", &CLICommandInfo{Subcommand: Describe}, true},
+		{"-nget describe pod x", &CLICommandInfo{Subcommand: Describe}, true},
+		{"-n=get describe pod x", &CLICommandInfo{Subcommand: Describe}, true},
+		{"--context logs get pods", &CLICommandInfo{Subcommand: Get}, true},
+		{"--cluster top get pods", &CLICommandInfo{Subcommand: Get}, true},
+		{"--user apply get pods", &CLICommandInfo{Subcommand: Get}, true},
+		{"--as logs get pods", &CLICommandInfo{Subcommand: Get}, true},
+		{"--as-group edit get pods", &CLICommandInfo{Subcommand: Get}, true},
+		{"--kubeconfig config get pods", &CLICommandInfo{Subcommand: Get}, true},
+		{"-s version get pods", &CLICommandInfo{Subcommand: Get}, true},
+		{"--server version get pods", &CLICommandInfo{Subcommand: Get}, true},
+		{"--token exec get pods", &CLICommandInfo{Subcommand: Get}, true},
+		{"--request-timeout 5s get pods", &CLICommandInfo{Subcommand: Get}, true},
+		{"-v 6 get pods", &CLICommandInfo{Subcommand: Get}, true},
+		{"-v=6 get pods", &CLICommandInfo{Subcommand: Get}, true},
+		{"--v 6 get pods", &CLICommandInfo{Subcommand: Get}, true},
+		{"--insecure-skip-tls-verify get pods", &CLICommandInfo{Subcommand: Get}, true},
+		{"--match-server-version describe pods", &CLICommandInfo{Subcommand: Describe}, true},
+		{"-n kube-system --context prod get pods -o wide", &CLICommandInfo{Subcommand: Get, FormatOption: Wide}, true},
+
+		// global flags after the subcommand
+		{"get pods -n describe", &CLICommandInfo{Subcommand: Get}, true},
+		{"get pods --context json -o yaml", &CLICommandInfo{Subcommand: Get, FormatOption: Yaml}, true},
+		{"describe -n logs pod x", &CLICommandInfo{Subcommand: Describe}, true},
+
+		// the subcommand is the first positional arg
+		{"foo get pods", &CLICommandInfo{}, false},
+		{"pods get", &CLICommandInfo{}, false},
+		{"-n default", &CLICommandInfo{}, false},
+		{"--help", &CLICommandInfo{Help: true}, false},
+		{"-h", &CLICommandInfo{Help: true}, false},
+		{"get logs", &CLICommandInfo{Subcommand: Get}, true},
+		{"explain get", &CLICommandInfo{Subcommand: Explain}, true},
+		{"describe pod describe", &CLICommandInfo{Subcommand: Describe}, true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.args, func(t *testing.T) {
+			t.Parallel()
+			args := strings.Split(tt.args, " ")
+			tt.expected.Args = args
+			s, ok := InspectCLICommandInfo(args)
+			if tt.expectedOK != ok {
+				t.Errorf("expectedOK got %v, want %v", ok, tt.expectedOK)
+			}
+			if diff := cmp.Diff(tt.expected, s); diff != "" {
+				t.Errorf("InspectCLICommandInfo() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInspectCLICommandInfo_SubcommandFlags(t *testing.T) {
+	tests := []struct {
+		args     string
+		expected *CLICommandInfo
+	}{
+		// output
+		{"get pods -o json", &CLICommandInfo{Subcommand: Get, FormatOption: Json}},
+		{"get pods -ojson", &CLICommandInfo{Subcommand: Get, FormatOption: Json}},
+		{"get pods -o=json", &CLICommandInfo{Subcommand: Get, FormatOption: Json}},
+		{"get pods --output json", &CLICommandInfo{Subcommand: Get, FormatOption: Json}},
+		{"get pods --output=json", &CLICommandInfo{Subcommand: Get, FormatOption: Json}},
+		{"get pods -o json -o yaml", &CLICommandInfo{Subcommand: Get, FormatOption: Yaml}},
+		{"get pods -o wide --output json", &CLICommandInfo{Subcommand: Get, FormatOption: Json}},
+		{"get pods -o custom-columns=NAME:.metadata.name", &CLICommandInfo{Subcommand: Get, FormatOption: CustomColumns}},
+		{"get pods -o", &CLICommandInfo{Subcommand: Get}},
+		{"get pods -l app=json", &CLICommandInfo{Subcommand: Get}},
+		{"get pods -l json", &CLICommandInfo{Subcommand: Get}},
+		{"get pods -L yaml", &CLICommandInfo{Subcommand: Get, LabelColumns: []string{"yaml"}}},
+		{"get pods --sort-by -o", &CLICommandInfo{Subcommand: Get}},
+		{"get pods --field-selector -w", &CLICommandInfo{Subcommand: Get}},
+
+		// "--raw" takes a value only in some subcommands
+		{"get --raw /api -o json", &CLICommandInfo{Subcommand: Get, FormatOption: Json}},
+		{"get --raw -o", &CLICommandInfo{Subcommand: Get}},
+		{"config view --raw -o json", &CLICommandInfo{Subcommand: Config, SubcommandPath: []string{"view"}, FormatOption: Json}},
+		{"config view --raw", &CLICommandInfo{Subcommand: Config, SubcommandPath: []string{"view"}}},
+
+		// label columns
+		{"get pods -L app", &CLICommandInfo{Subcommand: Get, LabelColumns: []string{"app"}}},
+		{"get pods -Lapp,tier --label-columns=app.kubernetes.io/version", &CLICommandInfo{Subcommand: Get, LabelColumns: []string{"app", "tier", "app.kubernetes.io/version"}}},
+		{"get pods -L app -o wide", &CLICommandInfo{Subcommand: Get, FormatOption: Wide, LabelColumns: []string{"app"}}},
+
+		// filename
+		{"apply -f - -o yaml", &CLICommandInfo{Subcommand: Apply, FormatOption: Yaml}},
+		{"apply -f -", &CLICommandInfo{Subcommand: Apply}},
+		{"apply -f -o", &CLICommandInfo{Subcommand: Apply}},
+		{"apply --filename=- -o json", &CLICommandInfo{Subcommand: Apply, FormatOption: Json}},
+		{"apply -k ./ -o json", &CLICommandInfo{Subcommand: Apply, FormatOption: Json}},
+		{"apply -Rf dir -o json", &CLICommandInfo{Subcommand: Apply, FormatOption: Json}},
+		{"diff -f -", &CLICommandInfo{Subcommand: Diff}},
+
+		// boolean flags
+		{"get pods -w", &CLICommandInfo{Subcommand: Get, Watch: true}},
+		{"get pods --watch", &CLICommandInfo{Subcommand: Get, Watch: true}},
+		{"get pods --watch=true", &CLICommandInfo{Subcommand: Get, Watch: true}},
+		{"get pods --watch=false", &CLICommandInfo{Subcommand: Get}},
+		{"get pods -Aw", &CLICommandInfo{Subcommand: Get, Watch: true}},
+		{"get pods -wA", &CLICommandInfo{Subcommand: Get, Watch: true}},
+		{"get pods -Ao wide", &CLICommandInfo{Subcommand: Get, FormatOption: Wide}},
+		{"get pods -Aowide", &CLICommandInfo{Subcommand: Get, FormatOption: Wide}},
+		{"get pods --no-headers", &CLICommandInfo{Subcommand: Get, NoHeader: true}},
+		{"get pods --no-headers=false", &CLICommandInfo{Subcommand: Get}},
+		{"get pods --show-labels -o wide", &CLICommandInfo{Subcommand: Get, FormatOption: Wide}},
+		{"version --short=true", &CLICommandInfo{Subcommand: Version, Short: true}},
+		{"version --short=false", &CLICommandInfo{Subcommand: Version}},
+		{"explain pods --recursive=false", &CLICommandInfo{Subcommand: Explain}},
+		{"explain pods --api-version v1 --recursive", &CLICommandInfo{Subcommand: Explain, Recursive: true}},
+		{"get pods --help=false", &CLICommandInfo{Subcommand: Get}},
+		{"delete pods --dry-run=server -o yaml", &CLICommandInfo{Subcommand: Delete, FormatOption: Yaml, DryRun: true}},
+		{"delete pods --dry-run -o yaml", &CLICommandInfo{Subcommand: Delete, FormatOption: Yaml, DryRun: true}},
+		{"create deployment nginx --image nginx --dry-run=client -o yaml", &CLICommandInfo{Subcommand: Create, SubcommandPath: []string{"deployment"}, FormatOption: Yaml, DryRun: true}},
+		{"apply -f pod.yaml --dry-run=true", &CLICommandInfo{Subcommand: Apply, DryRun: true}},
+		{"apply -f pod.yaml --dry-run=none", &CLICommandInfo{Subcommand: Apply}},
+		{"apply -f pod.yaml --dry-run=false", &CLICommandInfo{Subcommand: Apply}},
+
+		// flags which mean different things per subcommand
+		{"logs -f pod", &CLICommandInfo{Subcommand: Logs}},
+		{"logs -f -c app pod", &CLICommandInfo{Subcommand: Logs}},
+		{"logs -fp pod", &CLICommandInfo{Subcommand: Logs}},
+		{"logs --tail 10 -c -w pod", &CLICommandInfo{Subcommand: Logs}},
+		{"logs --since 1h pod", &CLICommandInfo{Subcommand: Logs}},
+		{"proxy -w -o", &CLICommandInfo{Subcommand: Proxy}},
+		{"proxy -w dir", &CLICommandInfo{Subcommand: Proxy}},
+		{"proxy -p 8001", &CLICommandInfo{Subcommand: Proxy}},
+		{"patch deploy x -p -w", &CLICommandInfo{Subcommand: Patch}},
+		{"patch deploy x --type merge -p {} -o yaml", &CLICommandInfo{Subcommand: Patch, FormatOption: Yaml}},
+		{"status -v", &CLICommandInfo{Subcommand: Status}},
+		{"status -v -o json", &CLICommandInfo{Subcommand: Status, FormatOption: Json}},
+		{"-v 2 status -v", &CLICommandInfo{Subcommand: Status}},
+		{"new-app -i -w nginx", &CLICommandInfo{Subcommand: NewApp}},
+		{"new-app -p -o nginx", &CLICommandInfo{Subcommand: NewApp}},
+		{"set env deploy/x --prefix -o", &CLICommandInfo{Subcommand: Set, SubcommandPath: []string{"env"}}},
+		{"set image deploy/x -c -o app=nginx", &CLICommandInfo{Subcommand: Set, SubcommandPath: []string{"image"}}},
+		{"scale deploy x --replicas 3 --current-replicas 2 -o yaml", &CLICommandInfo{Subcommand: Scale, FormatOption: Yaml}},
+		{"autoscale deploy x --min 1 --max 3 -o json", &CLICommandInfo{Subcommand: Autoscale, FormatOption: Json}},
+		{"rollout undo deploy/x --to-revision 2 -o json", &CLICommandInfo{Subcommand: Rollout, FormatOption: Json, SubcommandPath: []string{"undo"}}},
+		{"create secret generic x --from-literal -w", &CLICommandInfo{Subcommand: Create, SubcommandPath: []string{"secret", "generic"}}},
+		{"create deployment x --image nginx -o yaml", &CLICommandInfo{Subcommand: Create, SubcommandPath: []string{"deployment"}, FormatOption: Yaml}},
+		{"expose deploy x --port 80 --target-port -o", &CLICommandInfo{Subcommand: Expose}},
+		{"run x --image nginx --env -w", &CLICommandInfo{Subcommand: Run}},
+		{"wait --for condition=Ready pod/x --timeout 60s", &CLICommandInfo{Subcommand: Wait}},
+		{"drain node --pod-selector -o", &CLICommandInfo{Subcommand: Drain}},
+		{"port-forward --address 0.0.0.0 pod/x 8080", &CLICommandInfo{Subcommand: PortForward}},
+		{"debug -it pod/x --image busybox --target -o", &CLICommandInfo{Subcommand: Debug}},
+		{"cluster-info dump --output-directory -o", &CLICommandInfo{Subcommand: ClusterInfo, SubcommandPath: []string{"dump"}}},
+
+		// args after "--" belong to another command
+		{"exec -it pod -- kubectl get pods -o json", &CLICommandInfo{Subcommand: Exec}},
+		{"exec pod -c app -- ls --help", &CLICommandInfo{Subcommand: Exec}},
+		{"run x --image busybox -- -w", &CLICommandInfo{Subcommand: Run}},
+		{"debug node/x -it --image busybox -- sh", &CLICommandInfo{Subcommand: Debug}},
+		{"get pods -- -o json", &CLICommandInfo{Subcommand: Get}},
+		{"-- get pods", &CLICommandInfo{}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.args, func(t *testing.T) {
+			t.Parallel()
+			args := strings.Split(tt.args, " ")
+			tt.expected.Args = args
+			s, _ := InspectCLICommandInfo(args)
+			if diff := cmp.Diff(tt.expected, s); diff != "" {
+				t.Errorf("InspectCLICommandInfo() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_parseArgs(t *testing.T) {
+	tests := []struct {
+		args                    string
+		expectedSubcommand      CLICommand
+		expectedPositionals     []string
+		expectedFlags           []parsedFlag
+		expectedAfterDoubleDash []string
+	}{
+		{
+			args:                "get pods nginx",
+			expectedSubcommand:  Get,
+			expectedPositionals: []string{"pods", "nginx"},
+		},
+		{
+			args:                "-n kube-system get pods -ojson",
+			expectedSubcommand:  Get,
+			expectedPositionals: []string{"pods"},
+			expectedFlags: []parsedFlag{
+				{name: "-n", value: "kube-system", hasValue: true},
+				{name: "-o", value: "json", hasValue: true},
+			},
+		},
+		{
+			args:                "apply -f - --server-side --field-manager=me",
+			expectedSubcommand:  Apply,
+			expectedPositionals: nil,
+			expectedFlags: []parsedFlag{
+				{name: "-f", value: "-", hasValue: true},
+				{name: "--server-side"},
+				{name: "--field-manager", value: "me", hasValue: true},
+			},
+		},
+		{
+			args:                "exec -it pod -c app -- sh -c date",
+			expectedSubcommand:  Exec,
+			expectedPositionals: []string{"pod"},
+			expectedFlags: []parsedFlag{
+				{name: "-i"},
+				{name: "-t"},
+				{name: "-c", value: "app", hasValue: true},
+			},
+			expectedAfterDoubleDash: []string{"sh", "-c", "date"},
+		},
+		{
+			args:                "get pods -l",
+			expectedSubcommand:  Get,
+			expectedPositionals: []string{"pods"},
+			expectedFlags:       []parsedFlag{{name: "-l"}},
+		},
+		{
+			args:                "cp - pod:/tmp",
+			expectedSubcommand:  Cp,
+			expectedPositionals: []string{"-", "pod:/tmp"},
+		},
+		{
+			args:                "unknown get",
+			expectedPositionals: []string{"unknown", "get"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.args, func(t *testing.T) {
+			t.Parallel()
+			p := parseArgs(strings.Split(tt.args, " "))
+			if p.subcommand != tt.expectedSubcommand {
+				t.Errorf("subcommand got %v, want %v", p.subcommand, tt.expectedSubcommand)
+			}
+			if diff := cmp.Diff(tt.expectedPositionals, p.positionals); diff != "" {
+				t.Errorf("positionals mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.expectedFlags, p.flags, cmp.AllowUnexported(parsedFlag{})); diff != "" {
+				t.Errorf("flags mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.expectedAfterDoubleDash, p.afterDoubleDash); diff != "" {
+				t.Errorf("afterDoubleDash mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCLICommandInfo_CommandPath(t *testing.T) {
+	tests := []struct {
+		args     string
+		expected string
+	}{
+		{"get pods", "get"},
+		{"rollout status deploy/nginx", "rollout status"},
+		{"rollout -n default history deploy/nginx --revision 2", "rollout history"},
+		{"--context prod rollout restart deploy/nginx", "rollout restart"},
+		{"rollout deploy/nginx", "rollout"},
+		{"config get-contexts", "config get-contexts"},
+		{"config view --minify", "config view"},
+		{"config --kubeconfig x use-context prod", "config use-context"},
+		{"auth can-i get pods", "auth can-i"},
+		{"auth can-i --list", "auth can-i"},
+		{"auth whoami -o json", "auth whoami"},
+		{"certificate approve csr-1", "certificate approve"},
+		{"certificate deny csr-1", "certificate deny"},
+		{"set image deploy/nginx nginx=nginx:1.25", "set image"},
+		{"set env deploy/nginx FOO=bar", "set env"},
+		{"create secret generic x --from-literal=a=b", "create secret generic"},
+		{"create secret x", "create secret"},
+		{"create -f x.yaml", "create"},
+		{"create configmap status", "create configmap"},
+		{"rollout status status", "rollout status"},
+		{"apply view-last-applied deploy/x", "apply view-last-applied"},
+		{"top pod", "top"},
+		{"foo bar", ""},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.args, func(t *testing.T) {
+			t.Parallel()
+			info, _ := InspectCLICommandInfo(strings.Split(tt.args, " "))
+			if got := info.CommandPath(); got != tt.expected {
+				t.Errorf("CommandPath() got %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
@@ -5,13 +5,20 @@ import (
 )
 
 type CLICommandInfo struct {
-	Subcommand   CLICommand
-	FormatOption FormatOption
-	NoHeader     bool
-	Watch        bool
-	Help         bool
-	Recursive    bool
-	Short        bool
+	Subcommand CLICommand
+	// SubcommandPath is the rest of the command path after Subcommand,
+	// e.g. ["status"] for "rollout status" and ["secret", "generic"] for "create secret generic".
+	SubcommandPath []string
+	FormatOption   FormatOption
+	NoHeader       bool
+	Watch          bool
+	Help           bool
+	Recursive      bool
+	Short          bool
+	// DryRun is true when the command only shows what would be done by --dry-run=client or --dry-run=server
+	DryRun bool
+	// LabelColumns are the label keys given by -L, which are shown as the extra columns in kubectl get
+	LabelColumns []string
 
 	IsKrew bool
 	Args   []string
@@ -24,6 +31,13 @@ const (
 	Wide
 	Json
 	Yaml
+	Name
+	CustomColumns     // custom-columns=<spec>
+	CustomColumnsFile // custom-columns-file=<filename>
+	JsonPath          // jsonpath=<template>, jsonpath-file=<filename>
+	JsonPathAsJson    // jsonpath-as-json=<template>
+	GoTemplate        // go-template=<template>, template=<template>
+	TemplateFile      // go-template-file=<filename>, templatefile=<filename>
 )
 
 type CLICommand int
@@ -138,80 +152,99 @@ var strToCLICommand = map[string]CLICommand{
 	"policy":      Policy,
 }
 
+// subSubcommands are the known sub-subcommands of each command path.
+var subSubcommands = map[string][]string{
+	"apply":          {"edit-last-applied", "set-last-applied", "view-last-applied"},
+	"auth":           {"can-i", "reconcile", "whoami"},
+	"certificate":    {"approve", "deny"},
+	"cluster-info":   {"dump"},
+	"config":         {"current-context", "delete-cluster", "delete-context", "delete-user", "get-clusters", "get-contexts", "get-users", "rename-context", "set", "set-cluster", "set-context", "set-credentials", "unset", "use", "use-context", "view"},
+	"create":         {"clusterrole", "clusterrolebinding", "configmap", "cm", "cronjob", "cj", "deployment", "deploy", "ingress", "ing", "job", "namespace", "ns", "poddisruptionbudget", "pdb", "priorityclass", "pc", "quota", "resourcequota", "role", "rolebinding", "secret", "service", "svc", "serviceaccount", "sa", "token"},
+	"create secret":  {"docker-registry", "generic", "tls"},
+	"create service": {"clusterip", "externalname", "loadbalancer", "nodeport"},
+	"create svc":     {"clusterip", "externalname", "loadbalancer", "nodeport"},
+	"plugin":         {"list"},
+	"rollout":        {"history", "pause", "restart", "resume", "status", "undo"},
+	"set":            {"env", "image", "resources", "selector", "serviceaccount", "sa", "subject"},
+}
+
+func (c CLICommand) String() string {
+	for name, sc := range strToCLICommand {
+		if sc == c {
+			return name
+		}
+	}
+
+	return ""
+}
+
+// CommandPath returns the full command path, e.g. "rollout status".
+// It is empty when no subcommand is found.
+func (ci *CLICommandInfo) CommandPath() string {
+	return strings.Join(append([]string{ci.Subcommand.String()}, ci.SubcommandPath...), " ")
+}
+
+// Positionals returns the positional args after the command path, e.g. ["route", "my-route"] for "describe route my-route".
+func (ci *CLICommandInfo) Positionals() []string {
+	return parseArgs(ci.Args).positionals
+}
+
 func InspectCLICommand(command string) (CLICommand, bool) {
 	sc, ok := strToCLICommand[command]
 
 	return sc, ok
 }
 
+// CollectCommandlineOptions sets the options in args which change the output format to info.
 func CollectCommandlineOptions(args []string, info *CLICommandInfo) {
-	for i := range args {
-		if strings.HasPrefix(args[i], "--output") {
-			switch args[i] {
-			case "--output=json":
-				info.FormatOption = Json
-			case "--output=yaml":
-				info.FormatOption = Yaml
-			case "--output=wide":
-				info.FormatOption = Wide
-			default:
-				if len(args)-1 > i {
-					formatOption := args[i+1]
-					switch formatOption {
-					case "json":
-						info.FormatOption = Json
-					case "yaml":
-						info.FormatOption = Yaml
-					case "wide":
-						info.FormatOption = Wide
-					default:
-						// custom-columns, go-template, etc are currently not supported
-					}
-				}
-			}
-		} else if strings.HasPrefix(args[i], "-o") {
-			switch args[i] {
-			// both '-ojson' and '-o=json' works
-			case "-ojson", "-o=json":
-				info.FormatOption = Json
-			case "-oyaml", "-o=yaml":
-				info.FormatOption = Yaml
-			case "-owide", "-o=wide":
-				info.FormatOption = Wide
-			default:
-				// otherwise, look for next arg because '-o json' also works
-				if len(args)-1 > i {
-					formatOption := args[i+1]
-					switch formatOption {
-					case "json":
-						info.FormatOption = Json
-					case "yaml":
-						info.FormatOption = Yaml
-					case "wide":
-						info.FormatOption = Wide
-					default:
-						// custom-columns, go-template, etc are currently not supported
-					}
-				}
+	collectOptions(parseArgs(args), info)
+}
 
+func collectOptions(p *parsedArgs, info *CLICommandInfo) {
+	if f, ok := p.lastFlag("-o", "--output"); ok {
+		// the formats taking a template or a file are given as "format=value" e.g. "jsonpath={.metadata.name}"
+		format, _, _ := strings.Cut(f.value, "=")
+		switch format {
+		case "json":
+			info.FormatOption = Json
+		case "yaml":
+			info.FormatOption = Yaml
+		case "wide":
+			info.FormatOption = Wide
+		case "name":
+			info.FormatOption = Name
+		case "custom-columns":
+			info.FormatOption = CustomColumns
+		case "custom-columns-file":
+			info.FormatOption = CustomColumnsFile
+		case "jsonpath", "jsonpath-file":
+			info.FormatOption = JsonPath
+		case "jsonpath-as-json":
+			info.FormatOption = JsonPathAsJson
+		case "go-template", "template":
+			info.FormatOption = GoTemplate
+		case "go-template-file", "templatefile":
+			info.FormatOption = TemplateFile
+		}
+	}
+
+	info.Short = p.boolFlag("--short")
+	info.NoHeader = p.boolFlag("--no-headers")
+	info.Watch = p.boolFlag("-w", "--watch")
+	info.Recursive = p.boolFlag("--recursive")
+	info.Help = p.boolFlag("-h", "--help")
+
+	// --dry-run without value means --dry-run=client. "true" and "false" are deprecated but still accepted
+	if f, ok := p.lastFlag("--dry-run"); ok {
+		info.DryRun = !f.hasValue || (f.value != "none" && f.value != "false")
+	}
+
+	// -L can be given several times and each can have several keys e.g. "-L app,tier -L version"
+	for _, f := range p.allFlags("-L", "--label-columns") {
+		for _, key := range strings.Split(f.value, ",") {
+			if key = strings.TrimSpace(key); key != "" {
+				info.LabelColumns = append(info.LabelColumns, key)
 			}
-		} else if strings.HasPrefix(args[i], "--short") {
-			switch args[i] {
-			case "--short=true":
-				info.Short = true
-			case "--short=false":
-				info.Short = false
-			default:
-				info.Short = true
-			}
-		} else if args[i] == "--no-headers" {
-			info.NoHeader = true
-		} else if args[i] == "-w" || args[i] == "--watch" {
-			info.Watch = true
-		} else if args[i] == "--recursive=true" || args[i] == "--recursive" {
-			info.Recursive = true
-		} else if args[i] == "-h" || args[i] == "--help" {
-			info.Help = true
 		}
 	}
 }
@@ -220,17 +253,10 @@ func CollectCommandlineOptions(args []string, info *CLICommandInfo) {
 func InspectCLICommandInfo(args []string) (*CLICommandInfo, bool) {
 	ret := &CLICommandInfo{Args: args} // Store original args
 
-	CollectCommandlineOptions(args, ret)
-
-	for i := range args {
-		cmd, ok := InspectCLICommand(args[i])
-		if !ok {
-			continue
-		}
-
-		ret.Subcommand = cmd
-		return ret, true
-	}
+	parsed := parseArgs(args)
+	collectOptions(parsed, ret)
+	ret.Subcommand = parsed.subcommand
+	ret.SubcommandPath = parsed.subcommandPath
 
-	return ret, false
+	return ret, parsed.subcommandFound
 }
@@ -39,6 +39,18 @@ func TestInspectCLICommandInfo(t *testing.T) {
 		{"get pod --output wide", "get pod --output wide", &CLICommandInfo{Subcommand: Get, FormatOption: Wide, Args: []string{"get", "pod", "--output", "wide"}}, true},
 		{"get pod --output=wide", "get pod --output=wide", &CLICommandInfo{Subcommand: Get, FormatOption: Wide, Args: []string{"get", "pod", "--output=wide"}}, true},
 
+		{"get pod -o name", "get pod -o name", &CLICommandInfo{Subcommand: Get, FormatOption: Name, Args: []string{"get", "pod", "-o", "name"}}, true},
+		{"get pod -oname", "get pod -oname", &CLICommandInfo{Subcommand: Get, FormatOption: Name, Args: []string{"get", "pod", "-oname"}}, true},
+		{"get pod -o custom-columns", "get pod -o custom-columns=NAME:.metadata.name,NODE:.spec.nodeName", &CLICommandInfo{Subcommand: Get, FormatOption: CustomColumns, Args: []string{"get", "pod", "-o", "custom-columns=NAME:.metadata.name,NODE:.spec.nodeName"}}, true},
+		{"get pod -o=custom-columns-file", "get pod -o=custom-columns-file=columns.txt", &CLICommandInfo{Subcommand: Get, FormatOption: CustomColumnsFile, Args: []string{"get", "pod", "-o=custom-columns-file=columns.txt"}}, true},
+		{"get pod -o jsonpath", "get pod -o jsonpath={.items[*].metadata.name}", &CLICommandInfo{Subcommand: Get, FormatOption: JsonPath, Args: []string{"get", "pod", "-o", "jsonpath={.items[*].metadata.name}"}}, true},
+		{"get pod -ojsonpath-file", "get pod -ojsonpath-file=path.txt", &CLICommandInfo{Subcommand: Get, FormatOption: JsonPath, Args: []string{"get", "pod", "-ojsonpath-file=path.txt"}}, true},
+		{"get pod --output=jsonpath-as-json", "get pod --output=jsonpath-as-json={.items[*].metadata}", &CLICommandInfo{Subcommand: Get, FormatOption: JsonPathAsJson, Args: []string{"get", "pod", "--output=jsonpath-as-json={.items[*].metadata}"}}, true},
+		{"get pod -o go-template", "get pod -o go-template={{.metadata.name}}", &CLICommandInfo{Subcommand: Get, FormatOption: GoTemplate, Args: []string{"get", "pod", "-o", "go-template={{.metadata.name}}"}}, true},
+		{"get pod -o template", "get pod -o template={{.metadata.name}}", &CLICommandInfo{Subcommand: Get, FormatOption: GoTemplate, Args: []string{"get", "pod", "-o", "template={{.metadata.name}}"}}, true},
+		{"get pod -o go-template-file", "get pod -o go-template-file=pod.tmpl", &CLICommandInfo{Subcommand: Get, FormatOption: TemplateFile, Args: []string{"get", "pod", "-o", "go-template-file=pod.tmpl"}}, true},
+		{"get pod -o templatefile", "get pod -o templatefile=pod.tmpl", &CLICommandInfo{Subcommand: Get, FormatOption: TemplateFile, Args: []string{"get", "pod", "-o", "templatefile=pod.tmpl"}}, true},
+
 		{"get pod --no-headers", "get pod --no-headers", &CLICommandInfo{Subcommand: Get, NoHeader: true, Args: []string{"get", "pod", "--no-headers"}}, true},
 		{"get pod -w", "get pod -w", &CLICommandInfo{Subcommand: Get, Watch: true, Args: []string{"get", "pod", "-w"}}, true},
 		{"get pod --watch", "get pod --watch", &CLICommandInfo{Subcommand: Get, Watch: true, Args: []string{"get", "pod", "--watch"}}, true},
@@ -2,42 +2,167 @@ package printer
 
 import "github.com/hidetatz/kubecolor/color"
 
-var (
-	// Preset of colors for background
-	// Please use them when you just need random colors
-	colorsForDarkBackground = []color.Color{
-		color.Cyan,
-		color.Green,
-		color.Magenta,
-		color.White,
-		color.Yellow,
-	}
+// DarkTheme returns the built-in theme which looks good in dark-backgrounded environment.
+func DarkTheme() *Theme {
+	return &Theme{
+		Key:     []color.Style{{Fg: color.Yellow}, {Fg: color.White}},
+		String:  color.Style{Fg: color.Cyan},
+		Bool:    color.Style{Fg: color.Green},
+		Number:  color.Style{Fg: color.Magenta},
+		Null:    color.Style{Fg: color.Yellow},
+		Header:  color.Style{Fg: color.White},
+		Section: color.Style{Fg: color.Yellow},
+		Table: []color.Style{
+			{Fg: color.Cyan},
+			{Fg: color.Green},
+			{Fg: color.Magenta},
+			{Fg: color.White},
+			{Fg: color.Yellow},
+		},
+		Kind:   color.Style{Fg: color.Cyan},
+		Name:   color.Style{Fg: color.Green},
+		Recent: color.Style{Fg: color.Cyan, Attrs: color.Bold},
+
+		Default: color.Style{Fg: color.Green},
+		Help:    color.Style{Fg: color.Yellow},
+		Success: color.Style{Fg: color.Green},
+		Warning: color.Style{Fg: color.Yellow},
+		Error:   color.Style{Fg: color.Red},
 
-	colorsForLightBackground = []color.Color{
-		color.Cyan,
-		color.Green,
-		color.Magenta,
-		color.Black,
-		color.Yellow,
-		color.Blue,
+		Apply: ApplyTheme{
+			Created:    color.Style{Fg: color.Green},
+			Configured: color.Style{Fg: color.Yellow},
+			Unchanged:  color.Style{Fg: color.Magenta},
+			DryRun:     color.Style{Fg: color.Cyan},
+		},
+		Logs: LogsTheme{
+			Timestamp: color.Style{Fg: color.Cyan},
+			Fatal:     color.Style{Fg: color.Red, Attrs: color.Bold},
+			Error:     color.Style{Fg: color.Red},
+			Warn:      color.Style{Fg: color.Yellow},
+			Info:      color.Style{Fg: color.Green},
+			Debug:     color.Style{Fg: color.Magenta},
+			Trace:     color.Style{Fg: color.White},
+			Message:   color.Style{Attrs: color.Bold},
+			Caller:    color.Style{Attrs: color.Dim},
+		},
+		Columns: ColumnsTheme{
+			IP:         color.Style{Fg: color.Blue},
+			None:       color.Style{Attrs: color.Dim},
+			Registry:   color.Style{Attrs: color.Dim},
+			Repository: color.Style{Fg: color.Cyan},
+			Tag:        color.Style{Fg: color.Green},
+			LatestTag:  color.Style{Fg: color.Yellow, Attrs: color.Bold},
+			Key:        color.Style{Fg: color.Yellow},
+			KeyPrefix:  color.Style{Attrs: color.Dim},
+			Value:      color.Style{Fg: color.Cyan},
+			Separator:  color.Style{Attrs: color.Dim},
+		},
+		Diff: DiffTheme{
+			Header:           color.Style{Attrs: color.Bold},
+			Hunk:             color.Style{Fg: color.Cyan},
+			Added:            color.Style{Fg: color.Green},
+			Removed:          color.Style{Fg: color.Red},
+			AddedHighlight:   color.Style{Fg: color.Green, Attrs: color.Reverse},
+			RemovedHighlight: color.Style{Fg: color.Red, Attrs: color.Reverse},
+		},
+		Route: RouteTheme{
+			Key:            color.Style{Fg: color.Yellow},
+			ResourceName:   color.Style{Fg: color.Green},
+			Endpoint:       color.Style{Fg: color.Cyan},
+			TLSEdge:        color.Style{Fg: color.Blue},
+			TLSPassthrough: color.Style{Fg: color.Yellow},
+			TLSReencrypt:   color.Style{Fg: color.Yellow},
+			Comma:          color.Style{Fg: color.White},
+		},
+		OpenShift: OpenShiftTheme{
+			Project:          color.Style{Fg: color.Cyan},
+			Service:          color.Style{Fg: color.Green},
+			DeploymentConfig: color.Style{Fg: color.Blue},
+			URL:              color.Style{Fg: color.Magenta},
+		},
 	}
+}
 
-	// colors to be recommended to be used for some context
-	// e.g. Json, Yaml, kubectl-describe format etc.
+// LightTheme returns the built-in theme which looks good in light-backgrounded environment.
+func LightTheme() *Theme {
+	return &Theme{
+		Key:     []color.Style{{Fg: color.Yellow}, {Fg: color.Black}},
+		String:  color.Style{Fg: color.Blue},
+		Bool:    color.Style{Fg: color.Green},
+		Number:  color.Style{Fg: color.Magenta},
+		Null:    color.Style{Fg: color.Yellow},
+		Header:  color.Style{Fg: color.Black},
+		Section: color.Style{Fg: color.Yellow},
+		Table: []color.Style{
+			{Fg: color.Cyan},
+			{Fg: color.Green},
+			{Fg: color.Magenta},
+			{Fg: color.Black},
+			{Fg: color.Yellow},
+			{Fg: color.Blue},
+		},
+		Kind:   color.Style{Fg: color.Cyan},
+		Name:   color.Style{Fg: color.Green},
+		Recent: color.Style{Fg: color.Blue, Attrs: color.Bold},
 
-	// colors which look good in dark-backgrounded environment
-	KeyColorForDark    = color.White
-	StringColorForDark = color.Cyan
-	BoolColorForDark   = color.Green
-	NumberColorForDark = color.Magenta
-	NullColorForDark   = color.Yellow
-	HeaderColorForDark = color.White // for plain table
+		Default: color.Style{Fg: color.Green},
+		Help:    color.Style{Fg: color.Yellow},
+		Success: color.Style{Fg: color.Green},
+		Warning: color.Style{Fg: color.Yellow},
+		Error:   color.Style{Fg: color.Red},
 
-	// colors which look good in light-backgrounded environment
-	KeyColorForLight    = color.Black
-	StringColorForLight = color.Blue
-	BoolColorForLight   = color.Green
-	NumberColorForLight = color.Magenta
-	NullColorForLight   = color.Yellow
-	HeaderColorForLight = color.Black // for plain table
-)
+		Apply: ApplyTheme{
+			Created:    color.Style{Fg: color.Green},
+			Configured: color.Style{Fg: color.Yellow},
+			Unchanged:  color.Style{Fg: color.Magenta},
+			DryRun:     color.Style{Fg: color.Blue},
+		},
+		Logs: LogsTheme{
+			Timestamp: color.Style{Fg: color.Blue},
+			Fatal:     color.Style{Fg: color.Red, Attrs: color.Bold},
+			Error:     color.Style{Fg: color.Red},
+			Warn:      color.Style{Fg: color.Yellow},
+			Info:      color.Style{Fg: color.Green},
+			Debug:     color.Style{Fg: color.Magenta},
+			Trace:     color.Style{Fg: color.Black},
+			Message:   color.Style{Attrs: color.Bold},
+			Caller:    color.Style{Attrs: color.Dim},
+		},
+		Columns: ColumnsTheme{
+			IP:         color.Style{Fg: color.Magenta},
+			None:       color.Style{Attrs: color.Dim},
+			Registry:   color.Style{Attrs: color.Dim},
+			Repository: color.Style{Fg: color.Blue},
+			Tag:        color.Style{Fg: color.Green},
+			LatestTag:  color.Style{Fg: color.Yellow, Attrs: color.Bold},
+			Key:        color.Style{Fg: color.Yellow},
+			KeyPrefix:  color.Style{Attrs: color.Dim},
+			Value:      color.Style{Fg: color.Blue},
+			Separator:  color.Style{Attrs: color.Dim},
+		},
+		Diff: DiffTheme{
+			Header:           color.Style{Attrs: color.Bold},
+			Hunk:             color.Style{Fg: color.Blue},
+			Added:            color.Style{Fg: color.Green},
+			Removed:          color.Style{Fg: color.Red},
+			AddedHighlight:   color.Style{Fg: color.Green, Attrs: color.Reverse},
+			RemovedHighlight: color.Style{Fg: color.Red, Attrs: color.Reverse},
+		},
+		Route: RouteTheme{
+			Key:            color.Style{Fg: color.Yellow},
+			ResourceName:   color.Style{Fg: color.Green},
+			Endpoint:       color.Style{Fg: color.Cyan},
+			TLSEdge:        color.Style{Fg: color.Blue},
+			TLSPassthrough: color.Style{Fg: color.Yellow},
+			TLSReencrypt:   color.Style{Fg: color.Yellow},
+			Comma:          color.Style{Fg: color.White},
+		},
+		OpenShift: OpenShiftTheme{
+			Project:          color.Style{Fg: color.Cyan},
+			Service:          color.Style{Fg: color.Green},
+			DeploymentConfig: color.Style{Fg: color.Blue},
+			URL:              color.Style{Fg: color.Magenta},
+		},
+	}
+}
@@ -0,0 +1,136 @@
+package printer
+
+import (
+	"net"
+	"regexp"
+	"strings"
+
+	"github.com/hidetatz/kubecolor/color"
+)
+
+// ipAddress is a candidate of an IPv4 or IPv6 address which can be a CIDR, e.g. "10.244.0.5", "10.244.0.0/24" and "fd00::1".
+// It also matches the text which is not an address e.g. "12:30:45", so the candidates are checked by isIP.
+var ipAddress = regexp.MustCompile(`\b(?:\d{1,3}\.){3}\d{1,3}(?:/\d{1,2})?\b|(?:[0-9a-fA-F]{0,4}:){2,7}[0-9a-fA-F]{0,4}(?:/\d{1,3})?`)
+
+// colorizeIPs colors the IP addresses in s, e.g. "10.96.0.1" and "10.0.0.1:80,10.0.0.2:80".
+// ok is false when s has no IP address.
+func colorizeIPs(s string, theme *Theme) (colored string, ok bool) {
+	colored = ipAddress.ReplaceAllStringFunc(s, func(candidate string) string {
+		if !isIP(candidate) {
+			return candidate
+		}
+
+		ok = true
+		return color.Apply(candidate, theme.Columns.IP)
+	})
+	if !ok {
+		return "", false
+	}
+
+	return colored, true
+}
+
+// isIP returns true when s is an IP address or a CIDR.
+// "::" is not, though it's a valid address, because it's rather a separator in text.
+func isIP(s string) bool {
+	if s == "::" {
+		return false
+	}
+
+	if strings.Contains(s, "/") {
+		_, _, err := net.ParseCIDR(s)
+		return err == nil
+	}
+
+	return net.ParseIP(s) != nil
+}
+
+// colorizeImages colors the image references separated by commas,
+// e.g. "registry.k8s.io/pause:3.9,nginx:latest" in IMAGES column.
+func colorizeImages(s string, theme *Theme) string {
+	images := strings.Split(s, ",")
+	for i, image := range images {
+		images[i] = colorizeImage(image, theme)
+	}
+
+	return strings.Join(images, color.Apply(",", theme.Columns.Separator))
+}
+
+// colorizeImage colors the registry, the repository and the tag of an image reference,
+// e.g. "registry.k8s.io/pause:3.9" and "nginx@sha256:0d17b565...".
+// ":latest" is shown in its own color because it's not pinned to a version.
+func colorizeImage(image string, theme *Theme) string {
+	registry, rest := "", image
+	if first, after, found := strings.Cut(image, "/"); found && (strings.ContainsAny(first, ".:") || first == "localhost") {
+		registry, rest = first+"/", after
+	}
+
+	repository, digest, _ := strings.Cut(rest, "@")
+	tag := ""
+	if i := strings.LastIndex(repository, ":"); i != -1 {
+		repository, tag = repository[:i], repository[i:]
+	}
+
+	var b strings.Builder
+	if registry != "" {
+		b.WriteString(color.Apply(registry, theme.Columns.Registry))
+	}
+	b.WriteString(color.Apply(repository, theme.Columns.Repository))
+	switch tag {
+	case "":
+	case ":latest":
+		b.WriteString(color.Apply(tag, theme.Columns.LatestTag))
+	default:
+		b.WriteString(color.Apply(tag, theme.Columns.Tag))
+	}
+	if digest != "" {
+		b.WriteString(color.Apply("@"+digest, theme.Columns.Registry))
+	}
+
+	return b.String()
+}
+
+// colorizeKeyValues colors the pairs of key=value separated by commas,
+// e.g. "app=nginx,tier!=web" in SELECTOR column and "app=nginx,pod-template-hash=6799fc88d8" in LABELS column.
+// The parts which are not a pair are shown as values.
+func colorizeKeyValues(s string, theme *Theme) string {
+	pairs := strings.Split(s, ",")
+	for i, pair := range pairs {
+		key, value, found := strings.Cut(pair, "=")
+		if !found {
+			pairs[i] = color.Apply(pair, theme.Columns.Value)
+			continue
+		}
+
+		separator := "="
+		if strings.HasSuffix(key, "!") {
+			key, separator = strings.TrimSuffix(key, "!"), "!="
+		}
+
+		pairs[i] = colorizeLabelKey(key, theme) + color.Apply(separator, theme.Columns.Separator) + color.Apply(value, theme.Columns.Value)
+	}
+
+	return strings.Join(pairs, color.Apply(",", theme.Columns.Separator))
+}
+
+// wellKnownLabelDomains are the domains reserved for the labels of Kubernetes,
+// e.g. "app.kubernetes.io/name" and "node-role.kubernetes.io/control-plane".
+var wellKnownLabelDomains = []string{"kubernetes.io", "k8s.io"}
+
+// colorizeLabelKey colors a label key. The well-known prefix is shown in the key prefix color
+// so that the name part of the key stands out, e.g. "name" in "app.kubernetes.io/name".
+func colorizeLabelKey(key string, theme *Theme) string {
+	i := strings.LastIndex(key, "/")
+	if i == -1 {
+		return color.Apply(key, theme.Columns.Key)
+	}
+
+	prefix := key[:i]
+	for _, domain := range wellKnownLabelDomains {
+		if prefix == domain || strings.HasSuffix(prefix, "."+domain) {
+			return color.Apply(key[:i+1], theme.Columns.KeyPrefix) + color.Apply(key[i+1:], theme.Columns.Key)
+		}
+	}
+
+	return color.Apply(key, theme.Columns.Key)
+}
@@ -0,0 +1,97 @@
+package printer
+
+import (
+	"testing"
+
+	"github.com/hidetatz/kubecolor/color"
+	"github.com/hidetatz/kubecolor/testutil"
+)
+
+func Test_colorizeImage(t *testing.T) {
+	theme := ThemeForBackground(true)
+	registry := func(s string) string { return color.Apply(s, theme.Columns.Registry) }
+	repository := func(s string) string { return color.Apply(s, theme.Columns.Repository) }
+	tag := func(s string) string { return color.Apply(s, theme.Columns.Tag) }
+	latest := func(s string) string { return color.Apply(s, theme.Columns.LatestTag) }
+
+	tests := []struct {
+		image    string
+		expected string
+	}{
+		{"nginx", repository("nginx")},
+		{"nginx:1.25", repository("nginx") + tag(":1.25")},
+		{"nginx:latest", repository("nginx") + latest(":latest")},
+		{"bitnami/redis:7.2", repository("bitnami/redis") + tag(":7.2")},
+		{"registry.k8s.io/pause:3.9", registry("registry.k8s.io/") + repository("pause") + tag(":3.9")},
+		{"localhost:5000/app:dev", registry("localhost:5000/") + repository("app") + tag(":dev")},
+		{"localhost/app", registry("localhost/") + repository("app")},
+		{"nginx@sha256:0d17b565", repository("nginx") + registry("@sha256:0d17b565")},
+		{"nginx:1.25@sha256:0d17b565", repository("nginx") + tag(":1.25") + registry("@sha256:0d17b565")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.image, func(t *testing.T) {
+			t.Parallel()
+			testutil.MustEqual(t, tt.expected, colorizeImage(tt.image, theme))
+		})
+	}
+}
+
+func Test_colorizeIPs(t *testing.T) {
+	theme := ThemeForBackground(true)
+	ip := func(s string) string { return color.Apply(s, theme.Columns.IP) }
+
+	tests := []struct {
+		s          string
+		expected   string
+		expectedOK bool
+	}{
+		{"10.96.0.1", ip("10.96.0.1"), true},
+		{"10.244.0.0/24", ip("10.244.0.0/24"), true},
+		{"fd00::1", ip("fd00::1"), true},
+		{"10.0.0.1:80,10.0.0.2:80", ip("10.0.0.1") + ":80," + ip("10.0.0.2") + ":80", true},
+		{"10.244.0.0/24,fd00:10:244::/64", ip("10.244.0.0/24") + "," + ip("fd00:10:244::/64"), true},
+		{"10.0.0.1 at 12:30:45", ip("10.0.0.1") + " at 12:30:45", true},
+		{"<pending>", "", false},
+		{"a.example.com", "", false},
+		{"12:30:45", "", false},
+		{"2023-01-02T15:04:05Z", "", false},
+		{"::", "", false},
+		{"999.1.1.1", "", false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.s, func(t *testing.T) {
+			t.Parallel()
+			colored, ok := colorizeIPs(tt.s, theme)
+			testutil.MustEqual(t, tt.expected, colored)
+			testutil.MustEqual(t, tt.expectedOK, ok)
+		})
+	}
+}
+
+func Test_colorizeLabelKey(t *testing.T) {
+	theme := ThemeForBackground(true)
+	key := func(s string) string { return color.Apply(s, theme.Columns.Key) }
+	prefix := func(s string) string { return color.Apply(s, theme.Columns.KeyPrefix) }
+
+	tests := []struct {
+		key      string
+		expected string
+	}{
+		{"app", key("app")},
+		{"app.kubernetes.io/name", prefix("app.kubernetes.io/") + key("name")},
+		{"node-role.kubernetes.io/control-plane", prefix("node-role.kubernetes.io/") + key("control-plane")},
+		{"kubernetes.io/hostname", prefix("kubernetes.io/") + key("hostname")},
+		{"k8s.io/cluster-service", prefix("k8s.io/") + key("cluster-service")},
+		{"example.com/team", key("example.com/team")},
+		{"notkubernetes.io/team", key("notkubernetes.io/team")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.key, func(t *testing.T) {
+			t.Parallel()
+			testutil.MustEqual(t, tt.expected, colorizeLabelKey(tt.key, theme))
+		})
+	}
+}
@@ -19,18 +19,18 @@ func Test_getColorByKeyIndent(t *testing.T) {
 		dark             bool
 		indent           int
 		basicIndentWidth int
-		expected         color.Color
+		expected         color.Style
 	}{
-		{"dark depth: 1", true, 2, 2, color.White},
-		{"light depth: 1", false, 2, 2, color.Black},
-		{"dark depth: 2", true, 4, 2, color.Yellow},
-		{"light depth: 2", false, 4, 2, color.Yellow},
+		{"dark depth: 1", true, 2, 2, color.Style{Fg: color.White}},
+		{"light depth: 1", false, 2, 2, color.Style{Fg: color.Black}},
+		{"dark depth: 2", true, 4, 2, color.Style{Fg: color.Yellow}},
+		{"light depth: 2", false, 4, 2, color.Style{Fg: color.Yellow}},
 	}
 	for _, tt := range tests {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			got := getColorByKeyIndent(tt.indent, tt.basicIndentWidth, tt.dark)
+			got := getColorByKeyIndent(tt.indent, tt.basicIndentWidth, ThemeForBackground(tt.dark))
 			if got != tt.expected {
 				t.Errorf("fail: got: %v, expected: %v", got, tt.expected)
 			}
@@ -43,25 +43,25 @@ func Test_getColorByValueType(t *testing.T) {
 		name     string
 		dark     bool
 		val      string
-		expected color.Color
+		expected color.Style
 	}{
-		{"dark null", true, "null", NullColorForDark},
-		{"light null", false, "<none>", NullColorForLight},
+		{"dark null", true, "null", color.Style{Fg: color.Yellow}},
+		{"light null", false, "<none>", color.Style{Fg: color.Yellow}},
 
-		{"dark bool", true, "true", BoolColorForDark},
-		{"light bool", false, "false", BoolColorForLight},
+		{"dark bool", true, "true", color.Style{Fg: color.Green}},
+		{"light bool", false, "false", color.Style{Fg: color.Green}},
 
-		{"dark number", true, "123", NumberColorForDark},
-		{"light number", false, "456", NumberColorForLight},
+		{"dark number", true, "123", color.Style{Fg: color.Magenta}},
+		{"light number", false, "456", color.Style{Fg: color.Magenta}},
 
-		{"dark string", true, "aaa", StringColorForDark},
-		{"light string", false, "12345a", StringColorForLight},
+		{"dark string", true, "aaa", color.Style{Fg: color.Cyan}},
+		{"light string", false, "12345a", color.Style{Fg: color.Blue}},
 	}
 	for _, tt := range tests {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			got := getColorByValueType(tt.val, tt.dark)
+			got := getColorByValueType(tt.val, ThemeForBackground(tt.dark))
 			if got != tt.expected {
 				t.Errorf("fail: got: %v, expected: %v", got, tt.expected)
 			}
@@ -69,43 +69,26 @@ func Test_getColorByValueType(t *testing.T) {
 	}
 }
 
-func Test_getColorsByBackground(t *testing.T) {
+func Test_ThemeForBackground(t *testing.T) {
 	tests := []struct {
-		name     string
-		dark     bool
-		expected []color.Color
+		name           string
+		dark           bool
+		expectedTable  []color.Style
+		expectedHeader color.Style
 	}{
-		{"dark", true, colorsForDarkBackground},
-		{"light", false, colorsForLightBackground},
+		{"dark", true, []color.Style{{Fg: color.Cyan}, {Fg: color.Green}, {Fg: color.Magenta}, {Fg: color.White}, {Fg: color.Yellow}}, color.Style{Fg: color.White}},
+		{"light", false, []color.Style{{Fg: color.Cyan}, {Fg: color.Green}, {Fg: color.Magenta}, {Fg: color.Black}, {Fg: color.Yellow}, {Fg: color.Blue}}, color.Style{Fg: color.Black}},
 	}
 	for _, tt := range tests {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			got := getColorsByBackground(tt.dark)
-			if diff := cmp.Diff(got, tt.expected); diff != "" {
+			got := ThemeForBackground(tt.dark)
+			if diff := cmp.Diff(got.Table, tt.expectedTable); diff != "" {
 				t.Errorf("fail: %v", diff)
 			}
-		})
-	}
-}
-
-func Test_getHeaderColorByBackground(t *testing.T) {
-	tests := []struct {
-		name     string
-		dark     bool
-		expected color.Color
-	}{
-		{"dark", true, HeaderColorForDark},
-		{"light", false, HeaderColorForLight},
-	}
-	for _, tt := range tests {
-		tt := tt
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-			got := getHeaderColorByBackground(tt.dark)
-			if got != tt.expected {
-				t.Errorf("fail: got: %v, expected: %v", got, tt.expected)
+			if got.Header != tt.expectedHeader {
+				t.Errorf("fail: got: %v, expected: %v", got.Header, tt.expectedHeader)
 			}
 		})
 	}
@@ -10,18 +10,18 @@ import (
 )
 
 type JsonPrinter struct {
-	DarkBackground bool
+	Theme *Theme
 }
 
 func (jp *JsonPrinter) Print(r io.Reader, w io.Writer) {
 	scanner := bufio.NewScanner(r)
 	for scanner.Scan() {
 		line := scanner.Text()
-		printLineAsJsonFormat(line, w, jp.DarkBackground)
+		printLineAsJsonFormat(line, w, jp.Theme)
 	}
 }
 
-func printLineAsJsonFormat(line string, w io.Writer, dark bool) {
+func printLineAsJsonFormat(line string, w io.Writer, theme *Theme) {
 	indentCnt := findIndent(line)
 	indent := toSpaces(indentCnt)
 	trimmedLine := strings.TrimLeft(line, " ")
@@ -55,18 +55,18 @@ func printLineAsJsonFormat(line string, w io.Writer, dark bool) {
 
 	if len(splitted) == 1 {
 		// when coming here, it will be a value in an array
-		fmt.Fprintf(w, "%s%s\n", indent, toColorizedJsonValue(splitted[0], dark))
+		fmt.Fprintf(w, "%s%s\n", indent, toColorizedJsonValue(splitted[0], theme))
 		return
 	}
 
 	key := splitted[0]
 	val := splitted[1]
 
-	fmt.Fprintf(w, "%s%s: %s\n", indent, toColorizedJsonKey(key, indentCnt, 4, dark), toColorizedJsonValue(val, dark))
+	fmt.Fprintf(w, "%s%s: %s\n", indent, toColorizedJsonKey(key, indentCnt, 4, theme), toColorizedJsonValue(val, theme))
 }
 
 // toColorizedJsonKey returns colored json key
-func toColorizedJsonKey(key string, indentCnt, basicWidth int, dark bool) string {
+func toColorizedJsonKey(key string, indentCnt, basicWidth int, theme *Theme) string {
 	hasColon := strings.HasSuffix(key, ":")
 	// remove colon and double quotations although they might not exist actually
 	key = strings.TrimRight(key, ":")
@@ -77,13 +77,13 @@ func toColorizedJsonKey(key string, indentCnt, basicWidth int, dark bool) string
 		format += ":"
 	}
 
-	return fmt.Sprintf(format, color.Apply(doubleQuoteTrimmed, getColorByKeyIndent(indentCnt, basicWidth, dark)))
+	return fmt.Sprintf(format, color.Apply(doubleQuoteTrimmed, getColorByKeyIndent(indentCnt, basicWidth, theme)))
 }
 
 // toColorizedJsonValue returns colored json value.
 // This function checks it trailing comma and double quotation exist
 // then colorize the given value considering them.
-func toColorizedJsonValue(value string, dark bool) string {
+func toColorizedJsonValue(value string, theme *Theme) string {
 	if value == "{" {
 		return "{"
 	}
@@ -119,5 +119,5 @@ func toColorizedJsonValue(value string, dark bool) string {
 		format = `%s`
 	}
 
-	return fmt.Sprintf(format, color.Apply(doubleQuoteTrimmedValue, getColorByValueType(value, dark)))
+	return fmt.Sprintf(format, color.Apply(doubleQuoteTrimmedValue, getColorByValueType(value, theme)))
 }
@@ -10,7 +10,7 @@ import (
 )
 
 type ApplyPrinter struct {
-	DarkBackground bool
+	Theme *Theme
 }
 
 // kubectl apply
@@ -26,24 +26,11 @@ func (ap *ApplyPrinter) Print(r io.Reader, w io.Writer) {
 		dryRunStr = "(dry run)"
 	)
 
-	darkColors := map[string]color.Color{
-		applyActionCreated:    color.Green,
-		applyActionConfigured: color.Yellow,
-		applyActionUnchanged:  color.Magenta,
-		dryRunStr:             color.Cyan,
-	}
-	lightColors := map[string]color.Color{
-		applyActionCreated:    color.Green,
-		applyActionConfigured: color.Yellow,
-		applyActionUnchanged:  color.Magenta,
-		dryRunStr:             color.Blue,
-	}
-
-	colors := func(action string, dark bool) color.Color {
-		if dark {
-			return darkColors[action]
-		}
-		return lightColors[action]
+	colors := map[string]color.Style{
+		applyActionCreated:    ap.Theme.Apply.Created,
+		applyActionConfigured: ap.Theme.Apply.Configured,
+		applyActionUnchanged:  ap.Theme.Apply.Unchanged,
+		dryRunStr:             ap.Theme.Apply.DryRun,
 	}
 
 	colorize := func(line, action string, dryRun bool, wr io.Writer) {
@@ -51,14 +38,14 @@ func (ap *ApplyPrinter) Print(r io.Reader, w io.Writer) {
 			arg := strings.TrimSuffix(line, fmt.Sprintf(" %s %s", action, dryRunStr))
 			fmt.Fprintf(w, "%s %s %s\n",
 				arg,
-				color.Apply(action, colors(action, ap.DarkBackground)),
-				color.Apply(dryRunStr, colors(dryRunStr, ap.DarkBackground)),
+				color.Apply(action, colors[action]),
+				color.Apply(dryRunStr, colors[dryRunStr]),
 			)
 			return
 		}
 
 		arg := strings.TrimSuffix(line, " "+action)
-		fmt.Fprintf(w, "%s %s\n", arg, color.Apply(action, colors(action, ap.DarkBackground)))
+		fmt.Fprintf(w, "%s %s\n", arg, color.Apply(action, colors[action]))
 	}
 
 	scanner := bufio.NewScanner(r)
@@ -81,7 +68,7 @@ func (ap *ApplyPrinter) Print(r io.Reader, w io.Writer) {
 		case strings.HasSuffix(line, " "+applyActionUnchanged):
 			colorize(line, applyActionUnchanged, false, w)
 		default:
-			fmt.Fprintf(w, "%s\n", color.Apply(line, color.Green))
+			fmt.Fprintf(w, "%s\n", color.Apply(line, ap.Theme.Default))
 		}
 	}
 }
@@ -68,7 +68,7 @@ func Test_ApplyPrinter_Print(t *testing.T) {
 			t.Parallel()
 			r := strings.NewReader(tt.input)
 			var w bytes.Buffer
-			printer := ApplyPrinter{DarkBackground: tt.darkBackground}
+			printer := ApplyPrinter{Theme: ThemeForBackground(tt.darkBackground)}
 			printer.Print(r, &w)
 			testutil.MustEqual(t, tt.expected, w.String())
 		})
@@ -0,0 +1,41 @@
+package printer
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hidetatz/kubecolor/color"
+)
+
+// AuthCanIPrinter is a specific printer for kubectl auth can-i.
+// "yes" is shown in the success color and "no" in the error color.
+// With --list, the rules are shown as a table.
+type AuthCanIPrinter struct {
+	WithHeader bool
+	Theme      *Theme
+}
+
+func (ap *AuthCanIPrinter) Print(r io.Reader, w io.Writer) {
+	tp := NewTablePrinter(ap.WithHeader, ap.Theme, nil)
+	isFirstLine := true
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		withHeader := ap.WithHeader && isFirstLine
+		isFirstLine = false
+		switch {
+		case line == "yes":
+			fmt.Fprintf(w, "%s\n", color.Apply(line, ap.Theme.Success))
+		case line == "no", strings.HasPrefix(line, "no - "): // e.g. "no - RBAC: role.rbac.authorization.k8s.io "x" not found"
+			fmt.Fprintf(w, "%s\n", color.Apply(line, ap.Theme.Error))
+		case withHeader:
+			fmt.Fprintf(w, "%s\n", color.Apply(line, ap.Theme.Header))
+			tp.columns = parseTableHeader(line)
+		default:
+			// rules can consist of symbols only (e.g. "*.*"), so don't let the table printer guess the header
+			tp.printIndentedLineAsTableFormat(w, line, ap.Theme.Table)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package printer
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/hidetatz/kubecolor/testutil"
+)
+
+func Test_AuthCanIPrinter_Print(t *testing.T) {
+	tests := []struct {
+		name           string
+		darkBackground bool
+		withHeader     bool
+		input          string
+		expected       string
+	}{
+		{
+			name:           "yes",
+			darkBackground: true,
+			withHeader:     true,
+			input: testutil.NewHereDoc(`
+				yes`),
+			expected: testutil.NewHereDoc(`
+				[32myes[0m
+			`),
+		},
+		{
+			name:           "no",
+			darkBackground: true,
+			withHeader:     true,
+			input: testutil.NewHereDoc(`
+				no`),
+			expected: testutil.NewHereDoc(`
+				[31mno[0m
+			`),
+		},
+		{
+			name:           "no with a reason",
+			darkBackground: true,
+			withHeader:     true,
+			input: testutil.NewHereDoc(`
+				no - RBAC: clusterrole.rbac.authorization.k8s.io "foo" not found`),
+			expected: testutil.NewHereDoc(`
+				[31mno - RBAC: clusterrole.rbac.authorization.k8s.io "foo" not found[0m
+			`),
+		},
+		{
+			name:           "--list",
+			darkBackground: true,
+			withHeader:     true,
+			input: testutil.NewHereDoc(`
+				Resources   Non-Resource URLs   Resource Names   Verbs
+				*.*         []                  []               [*]
+				            [*]                 []               [*]`),
+			expected: testutil.NewHereDoc(`
+				[37mResources   Non-Resource URLs   Resource Names   Verbs[0m
+				[36m*.*[0m         [32m[][0m                  [35m[][0m               [37m[*][0m
+				            [32m[*][0m                 [35m[][0m               [37m[*][0m
+			`),
+		},
+		{
+			name:           "--list --no-headers",
+			darkBackground: true,
+			withHeader:     false,
+			input: testutil.NewHereDoc(`
+				pods        []                  []               [get list]`),
+			expected: testutil.NewHereDoc(`
+				[36mpods[0m        [32m[][0m                  [35m[][0m               [37m[get list][0m
+			`),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := strings.NewReader(tt.input)
+			var w bytes.Buffer
+			printer := AuthCanIPrinter{WithHeader: tt.withHeader, Theme: ThemeForBackground(tt.darkBackground)}
+			printer.Print(r, &w)
+			testutil.MustEqual(t, tt.expected, w.String())
+		})
+	}
+}
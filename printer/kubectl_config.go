@@ -0,0 +1,37 @@
+package printer
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hidetatz/kubecolor/color"
+)
+
+// ConfigGetContextsPrinter is a specific printer for kubectl config get-contexts.
+// The current context, marked with "*", is shown in the success color.
+//
+//	CURRENT   NAME        CLUSTER     AUTHINFO    NAMESPACE
+//	*         kind-kind   kind-kind   kind-kind
+//	          prod        prod        prod        default
+type ConfigGetContextsPrinter struct {
+	WithHeader bool
+	Theme      *Theme
+}
+
+func (cp *ConfigGetContextsPrinter) Print(r io.Reader, w io.Writer) {
+	tp := NewTablePrinter(cp.WithHeader, cp.Theme, nil)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case cp.WithHeader && tp.isFirstLine:
+			tp.printLine(w, line)
+		case strings.HasPrefix(line, "*"):
+			fmt.Fprintf(w, "%s\n", color.Apply(line, cp.Theme.Success))
+		default:
+			tp.printIndentedLineAsTableFormat(w, line, cp.Theme.Table)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package printer
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/hidetatz/kubecolor/testutil"
+)
+
+func Test_ConfigGetContextsPrinter_Print(t *testing.T) {
+	tests := []struct {
+		name           string
+		darkBackground bool
+		withHeader     bool
+		input          string
+		expected       string
+	}{
+		{
+			name:           "current context is colored",
+			darkBackground: true,
+			withHeader:     true,
+			input: testutil.NewHereDoc(`
+				CURRENT   NAME        CLUSTER     AUTHINFO    NAMESPACE
+				*         kind-kind   kind-kind   kind-kind   
+				          prod        prod        prod        default`),
+			expected: testutil.NewHereDoc(`
+				[37mCURRENT   NAME        CLUSTER     AUTHINFO    NAMESPACE[0m
+				[32m*         kind-kind   kind-kind   kind-kind   [0m
+				          [32mprod[0m        [35mprod[0m        [37mprod[0m        [33mdefault[0m
+			`),
+		},
+		{
+			name:           "--no-headers",
+			darkBackground: true,
+			withHeader:     false,
+			input: testutil.NewHereDoc(`
+				          prod        prod        prod        default
+				*         kind-kind   kind-kind   kind-kind   `),
+			expected: testutil.NewHereDoc(`
+				          [32mprod[0m        [35mprod[0m        [37mprod[0m        [33mdefault[0m
+				[32m*         kind-kind   kind-kind   kind-kind   [0m
+			`),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := strings.NewReader(tt.input)
+			var w bytes.Buffer
+			printer := ConfigGetContextsPrinter{WithHeader: tt.withHeader, Theme: ThemeForBackground(tt.darkBackground)}
+			printer.Print(r, &w)
+			testutil.MustEqual(t, tt.expected, w.String())
+		})
+	}
+}
@@ -11,11 +11,25 @@ import (
 
 // DescribePrinter is a specific printer to print kubectl describe format.
 type DescribePrinter struct {
-	DarkBackground bool
-	TablePrinter   *TablePrinter
+	Theme        *Theme
+	TablePrinter *TablePrinter
+	// IsRoute is true when the command describes routes e.g. `oc describe route my-route`.
+	// Otherwise, a route is found by its fields e.g. "Requested Host:", and only the lines after it are colored as a route.
+	IsRoute bool
+	// Statuses tells the severities of the values of the status fields e.g. "Status:" and "Reason:",
+	// and of the status columns of the tables e.g. "Type" and "Reason" of events.
+	// The default statuses are used when it's nil.
+	Statuses StatusRegistry
+	// StableColors colors the namespace, the node and the owner by the hash of their names,
+	// so they look the same as in kubectl get and logs.
+	StableColors bool
+
+	section     string   // the top-level section being printed e.g. "Conditions"
+	tableHeader []string // the header of the table being printed, split as the table printer does
+	tableRow    []string // the row of the table being printed
 }
 
-// Define route-specific keywords and colors at package level or within the struct if preferred
+// Define route-specific keywords at package level. Their colors are defined in Theme.Route
 var (
 	routeDetectionKeywords = []string{"Requested Host:", "TLS Termination:", "Ingress:"} // Keywords highly specific to routes for detection
 	routeSpecificKeys      = map[string]bool{
@@ -32,258 +46,327 @@ var (
 		"Endpoints:":       true,
 		"Ingress:":         true,
 	}
-	routeKeyColor            = color.Yellow
-	routeResourceNameColor   = color.Green
-	routeEndpointColor       = color.Cyan
-	routeTLSColorEdge        = color.Blue
-	routeTLSColorPassthrough = color.Yellow
-	routeTLSColorReencrypt   = color.Yellow
-	routeCommaColor          = color.White
-
-	// aliases for backward compatibility with older variable names
-	ocRouteKeyColor            = routeKeyColor
-	ocRouteResourceNameColor   = routeResourceNameColor
-	ocRouteEndpointColor       = routeEndpointColor
-	ocRouteTLSColorEdge        = routeTLSColorEdge
-	ocRouteTLSColorPassthrough = routeTLSColorPassthrough
-	ocRouteTLSColorReencrypt   = routeTLSColorReencrypt
-	ocRouteCommaColor          = routeCommaColor
 )
 
+// describeStatusKeys are the keys whose values are statuses, e.g. "Status:  Running" and "Reason:  CrashLoopBackOff"
+var describeStatusKeys = map[string]bool{
+	"Status:":     true,
+	"Phase:":      true,
+	"State:":      true,
+	"Last State:": true,
+	"Reason:":     true,
+}
+
+const describeIndentWidth = 2 // according to kubectl describe format
+
 func (dp *DescribePrinter) Print(r io.Reader, w io.Writer) {
-	basicIndentWidth := 2 // according to kubectl describe format
+	// each line is written as soon as it's read, so a slow describe shows its output without waiting for the rest
+	isRoute := dp.IsRoute
 	scanner := bufio.NewScanner(r)
-	isRoute := false // Flag to indicate if current resource is likely a route
-
 	for scanner.Scan() {
 		line := scanner.Text()
+		// the fields before the route-specific ones are shown as usual when the command didn't tell it's a route
+		if !isRoute && isRouteLine(line) {
+			isRoute = true
+		}
+		dp.printLine(w, line, isRoute)
+	}
+}
 
-		// Attempt to detect if this is a route description
-		if !isRoute {
-			for _, keyword := range routeDetectionKeywords {
-				if strings.Contains(line, keyword) {
-					isRoute = true
-					break
-				}
-			}
+// isRouteResource returns true when the resource type in the positional args of describe is route,
+// e.g. "route my-route", "routes", "route/my-route" and "routes.route.openshift.io".
+func isRouteResource(positionals []string) bool {
+	if len(positionals) == 0 {
+		return false
+	}
+
+	resource, _, _ := strings.Cut(positionals[0], "/")
+	resource, _, _ = strings.Cut(resource, ".")
+	return resource == "route" || resource == "routes"
+}
+
+// isRouteLine returns true if line has a field which only `oc describe route` shows.
+func isRouteLine(line string) bool {
+	for _, keyword := range routeDetectionKeywords {
+		if strings.Contains(line, keyword) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (dp *DescribePrinter) printLine(w io.Writer, line string, isRoute bool) {
+	if line == "" {
+		dp.tableHeader = nil
+		fmt.Fprintln(w)
+		return
+	}
+
+	// Split a line by spaces to colorize and render them
+	// For example:
+	// e.g. 1-----------------
+	// Status:         Running
+	// -----------------------
+	// spacesIndices: [[7, 15]] // <- where spaces locate
+	// columns: ["Status:", "Running"]
+	//
+	// e.g. 2--------------------------------------------
+	//     Ports:          10001/TCP, 5000/TCP, 18000/TCP
+	// --------------------------------------------------
+	// spacesIndices: [[0, 3], [10, 19]] // <- where spaces locate
+	// columns: ["Ports:", "10001/TCP, 5000/TCP, 18000/TCP"]
+	//
+	// So now, we know where to render which column.
+	spacesIndices := spaces.FindAllStringIndex(line, -1)
+	columns := spaces.Split(line, -1)
+	// when the line has indent (spaces on left), the first item will be
+	// just a "" and we don't need it so remove
+	if columns[0] == "" {
+		columns = columns[1:]
+	}
+
+	// First, identify if there is an indent
+	indentCnt := findIndent(line)
+	indent := toSpaces(indentCnt)
+	// TODO: Remove this condition for workaround
+	// Basically, kubectl describe output has its indentation level
+	// with **2** spaces, but "Resource Quota" section in
+	// `kubectl describe ns` output has only 1 space at the head.
+	// Because of it, indentCnt is still 1, but the indent space is not in `spacesIndices` (see regex definition of `spaces`)
+	// So it must be checked here
+	// https://github.com/hidetatz/kubecolor/issues/36
+	// When https://github.com/kubernetes/kubectl/issues/1005#issuecomment-758385759 is fixed
+	// this is not needed anymore.
+	if indentCnt > 1 {
+		// when an indent exists, removes it because it's already captured by "indent" var
+		spacesIndices = spacesIndices[1:]
+	}
+
+	// when there are multiple columns, treat is as table format
+	if len(columns) > 2 {
+		// the first line of a table is its header e.g. "Type  Reason  Age  From  Message" of events
+		dp.tableRow = spaces.Split(line, -1)
+		if dp.tableHeader == nil {
+			dp.tableHeader = dp.tableRow
 		}
+		dp.TablePrinter.printLineAsTableFormat(w, line, dp.Theme.Table)
+		return
+	}
+	dp.tableHeader = nil
 
-		if line == "" {
-			fmt.Fprintln(w)
-			continue
+	// with the workaround above, the first column can still have the 1-space indent
+	key := strings.TrimLeft(columns[0], " ")
+	if indentCnt == 0 {
+		dp.section = ""
+		if len(columns) == 1 && strings.HasSuffix(key, ":") {
+			dp.section = strings.TrimSuffix(key, ":")
 		}
+	}
 
-		// Split a line by spaces to colorize and render them
-		// For example:
-		// e.g. 1-----------------
-		// Status:         Running
-		// -----------------------
-		// spacesIndices: [[7, 15]] // <- where spaces locate
-		// columns: ["Status:", "Running"]
-		//
-		// e.g. 2--------------------------------------------
-		//     Ports:          10001/TCP, 5000/TCP, 18000/TCP
-		// --------------------------------------------------
-		// spacesIndices: [[0, 3], [10, 19]] // <- where spaces locate
-		// columns: ["Ports:", "10001/TCP, 5000/TCP, 18000/TCP"]
-		//
-		// So now, we know where to render which column.
-		spacesIndices := spaces.FindAllStringIndex(line, -1)
-		columns := spaces.Split(line, -1)
-		// when the line has indent (spaces on left), the first item will be
-		// just a "" and we don't need it so remove
-		if len(columns) > 0 {
-			if columns[0] == "" {
-				columns = columns[1:]
-			}
+	if len(columns) == 1 {
+		// routes have some fields separated by only 1 space e.g. "Requested Host: www.example.com"
+		if kv := strings.SplitN(key, ": ", 2); isRoute && len(kv) == 2 {
+			dp.printRouteKeyValue(w, indent, indentCnt, kv[0]+":", " ", kv[1])
+			return
 		}
 
-		// First, identify if there is an indent
-		indentCnt := findIndent(line)
-		indent := toSpaces(indentCnt)
-		if indentCnt > 0 {
-			// TODO: Remove this condition for workaround
-			// Basically, kubectl describe output has its indentation level
-			// with **2** spaces, but "Resource Quota" section in
-			// `kubectl describe ns` output has only 1 space at the head.
-			// Because of it, indentCnt is still 1, but the indent space is not in `spacesIndices` (see regex definition of `spaces`)
-			// So it must be checked here
-			// https://github.com/hidetatz/kubecolor/issues/36
-			// When https://github.com/kubernetes/kubectl/issues/1005#issuecomment-758385759 is fixed
-			// this is not needed anymore.
-			if indentCnt > 1 {
-				// when an indent exists, removes it because it's already captured by "indent" var
-				spacesIndices = spacesIndices[1:]
+		// a line which has only a key is a title of the section below it e.g. "Containers:"
+		if strings.HasSuffix(key, ":") {
+			style := getColorByKeyIndent(indentCnt, describeIndentWidth, dp.Theme)
+			if indentCnt == 0 {
+				style = dp.Theme.Section
 			}
+			fmt.Fprintf(w, "%s%s:\n", indent, color.Apply(strings.TrimSuffix(key, ":"), style))
+			return
 		}
 
-		spacesCnt := 0
-		if len(spacesIndices) > 0 {
-			spacesCnt = spacesIndices[0][1] - spacesIndices[0][0]
+		// otherwise, it's a descriptive text e.g. "(Total limits may be over 100 percent, i.e., overcommitted.)",
+		// which is shown in the key color of its indent. In routes, it's shown as a value.
+		style := getColorByKeyIndent(indentCnt, describeIndentWidth, dp.Theme)
+		if isRoute {
+			style = getColorByValueType(key, dp.Theme)
 		}
+		fmt.Fprintf(w, "%s%s\n", indent, color.Apply(key, style))
+		return
+	}
 
-		// when there are multiple columns, treat is as table format
-		if len(columns) > 2 {
-			dp.TablePrinter.printLineAsTableFormat(w, line, getColorsByBackground(dp.DarkBackground))
-			continue
+	separator := toSpaces(spacesIndices[0][1] - spacesIndices[0][0])
+	if isRoute {
+		dp.printRouteKeyValue(w, indent, indentCnt, key, separator, columns[1])
+		return
+	}
+
+	fmt.Fprintf(w, "%s%s%s%s\n",
+		indent,
+		dp.colorizeKey(key, getColorByKeyIndent(indentCnt, describeIndentWidth, dp.Theme)),
+		separator,
+		dp.colorizeValue(key, columns[1]),
+	)
+}
+
+// colorizeValue colors the value of key. A status is colored by its severity.
+func (dp *DescribePrinter) colorizeValue(key, value string) string {
+	if dp.StableColors {
+		if colored, ok := dp.colorizeIdentity(key, value); ok {
+			return colored
 		}
+	}
 
-		// Determine effective colors to use, initially based on generic logic
-		effectiveKeyColor := getColorByKeyIndent(indentCnt, basicIndentWidth, dp.DarkBackground)
-		effectiveValColor := getColorByValueType(columns[0], dp.DarkBackground) // Default for single-column lines
-		if len(columns) > 1 {
-			effectiveValColor = getColorByValueType(columns[1], dp.DarkBackground) // Default for value part of key-value
+	// e.g. "Ready  False" in the conditions of pods
+	if dp.section == "Conditions" {
+		if style, ok := dp.Statuses.conditionStyle(key, value, dp.Theme); ok {
+			return color.Apply(value, style)
 		}
+	}
 
-		if isRoute && len(columns) > 0 {
-			keyPart := columns[0]
-			// Trim space for map lookups and switch, but preserve original keyPart for printing if needed.
-			trimmedSpaceKeyPart := strings.TrimSpace(keyPart)
-			// For map lookups, ensure the key has a colon if it's a primary field.
-			// This logic assumes routeSpecificKeys stores keys exactly as they appear, e.g. "Name:", "  Host:"
-			keyForMapLookup := keyPart
-
-			// Override Key Color for Routes
-			if _, ok := routeSpecificKeys[keyForMapLookup]; ok {
-				effectiveKeyColor = ocRouteKeyColor
-			} else if indentCnt > basicIndentWidth {
-				// For indented keys within a route section (e.g. "Host:" under "Ingress:")
-				// If the space-trimmed version (e.g. "Host:") is in our specific map, use route key color.
-				// This allows routeSpecificKeys to define "Host:" for sub-sections without leading spaces.
-				if _, okSubKey := routeSpecificKeys[trimmedSpaceKeyPart+":"]; okSubKey {
-					effectiveKeyColor = ocRouteKeyColor
-				} else {
-					// Otherwise, use generic indentation logic for unknown sub-keys.
-					effectiveKeyColor = getColorByKeyIndent(indentCnt, basicIndentWidth+1, dp.DarkBackground)
-				}
-			}
-			// else, default effectiveKeyColor from generic logic applies.
-
-			// Override Value Color for Routes (if a value column exists)
-			if len(columns) > 1 {
-				valuePart := columns[1]
-				// Use space-trimmed key (without colon) for switch cases for cleaner matching.
-				switch strings.TrimSuffix(trimmedSpaceKeyPart, ":") {
-				case "Name", "Requested Host":
-					effectiveValColor = ocRouteResourceNameColor
-				case "Service":
-					effectiveValColor = ocRouteResourceNameColor
-				case "Endpoints":
-					effectiveValColor = ocRouteEndpointColor
-				case "TLS Termination":
-					if strings.HasPrefix(strings.ToLower(valuePart), "edge") {
-						effectiveValColor = ocRouteTLSColorEdge
-					} else if strings.HasPrefix(strings.ToLower(valuePart), "passthrough") {
-						effectiveValColor = ocRouteTLSColorPassthrough
-					} else if strings.HasPrefix(strings.ToLower(valuePart), "reencrypt") {
-						effectiveValColor = ocRouteTLSColorReencrypt
-					}
-					// else, effectiveValColor from generic typing is used if no prefix matches.
-				}
-				// For other keys not listed, effectiveValColor (from generic typing) is used.
-			} else if len(columns) == 1 && !strings.HasSuffix(keyPart, ":") {
-				// Single column, not a key. If isRoute, it's likely descriptive text.
-				effectiveValColor = getColorByValueType(keyPart, dp.DarkBackground)
-				effectiveKeyColor = effectiveValColor // Print this line with the value's color
+	if describeStatusKeys[key] {
+		// e.g. "Terminating (lasts 2m)"
+		status, rest, _ := strings.Cut(value, " ")
+		if style, ok := dp.Statuses.style(status, dp.Theme); ok {
+			if rest == "" {
+				return color.Apply(status, style)
 			}
+			return color.Apply(status, style) + " " + color.Apply(rest, getColorByValueType(rest, dp.Theme))
 		}
+	}
+
+	return color.Apply(value, getColorByValueType(value, dp.Theme))
+}
+
+// tableColor is the ColorDeciderFn of TablePrinter, which colors the cells of the status columns in the tables,
+// e.g. "Warning" and "BackOff" in events and "False" in "Ready  False  KubeletNotReady" of conditions.
+// The other cells, e.g. the condition type "Ready", get the colors of their positions.
+func (dp *DescribePrinter) tableColor(i int, cell string) (color.Style, bool) {
+	if i >= len(dp.tableHeader) {
+		return color.Style{}, false
+	}
 
-		// TODO: Remove this if statement for workaround (Kubectl 1.19.3 bug)
-		// This workaround is for lines like " Resource Used Hard" where "Resource" has one leading space.
-		// The current column splitting logic might already handle this if columns[0] becomes empty and is removed.
-		// If columns[0] is the first word (e.g. "Resource") but indentCnt is 1 due to the bug,
-		// this check might be needed.
-		keyToPrint := columns[0]
-		if indentCnt == 1 && strings.HasPrefix(line, " ") && !strings.HasPrefix(line, "  ") && columns[0] != "" {
-			// Heuristic for the single-space indent bug if columns[0] isn't empty.
-			// If columns[0] is already trimmed by the initial split logic, this specific check might not be needed.
-			// The original code did `columns[0] = strings.TrimLeft(columns[0], " ")` which is more aggressive.
-			// Let's ensure keyToPrint is the actual content intended for the key column.
-		} else if strings.HasPrefix(keyToPrint, " ") && indentCnt > 0 && len(spacesIndices) > 0 && spacesIndices[0][0] == 0 {
-			// This was the original logic to handle the workaround, let's refine it.
-			// If the first column still has leading spaces AND it's an indented line, trim.
-			// This should ideally be handled by the columns splitting logic or findIndent.
-			// For now, if columns[0] (keyToPrint) has leading spaces and it's not a non-indented line,
-			// it might be an artifact of the split.
+	switch dp.tableHeader[i] {
+	case "Status":
+		if dp.section == "Conditions" {
+			return dp.Statuses.conditionStyle(dp.tableCell("Type"), cell, dp.Theme)
 		}
+		return dp.Statuses.style(cell, dp.Theme)
+	case "Reason":
+		return dp.Statuses.style(cell, dp.Theme)
+	case "Type":
+		// the type of a condition is its name
+		if dp.section != "Conditions" {
+			return dp.Statuses.style(cell, dp.Theme)
+		}
+	}
+
+	return color.Style{}, false
+}
 
-		// Apply coloring to the key part that will be printed
-		// Use the original columns[0] for TrimRight because keyToPrint might have been trimmed.
-		// However, the content to color should be from keyToPrint if it was modified.
-		finalKeyString := strings.TrimRight(keyToPrint, ":")
-		coloredKeyOutput := color.Apply(finalKeyString, effectiveKeyColor)
-		if strings.HasSuffix(keyToPrint, ":") {
-			coloredKeyOutput += ":"
+// tableCell returns the cell of the column named name in the row being printed. It's empty when there is no such column.
+func (dp *DescribePrinter) tableCell(name string) string {
+	for i, column := range dp.tableHeader {
+		if column == name && i < len(dp.tableRow) {
+			return dp.tableRow[i]
 		}
+	}
+
+	return ""
+}
 
-		if len(columns) == 1 {
-			fmt.Fprintf(w, "%s%s\n", indent, coloredKeyOutput) // Print single column line with its determined color
-			continue
+// colorizeIdentity colors the value of key by its stable color when it's an identity,
+// e.g. "Namespace:  kube-system", "Node:  minikube/192.168.49.2" and "Controlled By:  ReplicaSet/coredns-5d78c9869d".
+func (dp *DescribePrinter) colorizeIdentity(key, value string) (string, bool) {
+	switch key {
+	case "Namespace:":
+		return color.Apply(value, stableColor(value, dp.Theme.Table)), true
+	case "Node:":
+		// the node name is followed by its IP
+		node, ip, found := strings.Cut(value, "/")
+		colored := color.Apply(node, stableColor(node, dp.Theme.Table))
+		if found {
+			colored += "/" + color.Apply(ip, getColorByValueType(ip, dp.Theme))
 		}
+		return colored, true
+	case "Controlled By:":
+		kind, name, found := strings.Cut(value, "/")
+		if !found {
+			return "", false
+		}
+		return color.Apply(kind, getColorByValueType(kind, dp.Theme)) + "/" + color.Apply(name, stableColor(ownerPrefix(name), dp.Theme.Table)), true
+	}
 
-		// len(columns) > 1, so there is a value part
-		valueOutput := columns[1]
+	return "", false
+}
 
-		// Specific rendering for multi-part values in routes
-		if isRoute {
-			// Use TrimSpace for switch to handle keys like "  Service:" correctly
-			trimmedKeyForSwitch := strings.TrimSuffix(strings.TrimSpace(columns[0]), ":")
-			switch trimmedKeyForSwitch {
-			case "Service":
-				if strings.Contains(valueOutput, "(") && strings.Contains(valueOutput, "%") {
-					parts := strings.SplitN(valueOutput, " ", 2)
-					serviceNameColored := color.Apply(parts[0], ocRouteResourceNameColor)
-					weightPartColored := ""
-					if len(parts) > 1 {
-						weightPartColored = " " + color.Apply(parts[1], getColorByValueType(parts[1], dp.DarkBackground))
-					}
-					fmt.Fprintf(w, "%s%s%s%s\n", indent, coloredKeyOutput, toSpaces(spacesCnt), serviceNameColored+weightPartColored)
-					continue
-				}
-			case "Endpoints":
-				endpointParts := strings.Split(valueOutput, ",")
-				var coloredEpStrings []string
-				for i, ep := range endpointParts {
-					trimmedEp := strings.TrimSpace(ep)
-					coloredEpStrings = append(coloredEpStrings, color.Apply(trimmedEp, ocRouteEndpointColor))
-					if i < len(endpointParts)-1 {
-						coloredEpStrings = append(coloredEpStrings, color.Apply(",", ocRouteCommaColor)+" ")
-					}
-				}
-				fmt.Fprintf(w, "%s%s%s%s\n", indent, coloredKeyOutput, toSpaces(spacesCnt), strings.Join(coloredEpStrings, ""))
-				continue
-			case "TLS Termination":
-				var finalTLSOutput string
-				spaceIdx := strings.Index(valueOutput, " ")
-				firstWord := valueOutput
-				remainingText := ""
-				if spaceIdx != -1 {
-					firstWord = valueOutput[:spaceIdx]
-					remainingText = valueOutput[spaceIdx:]
-				}
-				lowerFirstWord := strings.ToLower(firstWord)
-				coloredFirstWord := ""
-
-				if lowerFirstWord == "edge" {
-					coloredFirstWord = color.Apply(firstWord, ocRouteTLSColorEdge)
-				} else if lowerFirstWord == "passthrough" {
-					coloredFirstWord = color.Apply(firstWord, ocRouteTLSColorPassthrough)
-				} else if lowerFirstWord == "reencrypt" {
-					coloredFirstWord = color.Apply(firstWord, ocRouteTLSColorReencrypt)
-				} else {
-					// If no specific keyword, color the first word with the general value color determined earlier
-					coloredFirstWord = color.Apply(firstWord, effectiveValColor)
-				}
-				finalTLSOutput = coloredFirstWord
-				if remainingText != "" {
-					finalTLSOutput += color.Apply(remainingText, getColorByValueType(remainingText, dp.DarkBackground))
-				}
-				fmt.Fprintf(w, "%s%s%s%s\n", indent, coloredKeyOutput, toSpaces(spacesCnt), finalTLSOutput)
-				continue
-			}
+// colorizeKey colors key except its trailing colon.
+func (dp *DescribePrinter) colorizeKey(key string, style color.Style) string {
+	colored := color.Apply(strings.TrimSuffix(key, ":"), style)
+	if strings.HasSuffix(key, ":") {
+		colored += ":"
+	}
+
+	return colored
+}
+
+// printRouteKeyValue prints a field of `oc describe route`.
+func (dp *DescribePrinter) printRouteKeyValue(w io.Writer, indent string, indentCnt int, key, separator, value string) {
+	keyStyle := getColorByKeyIndent(indentCnt, describeIndentWidth, dp.Theme)
+	switch {
+	case isRouteDetectionKeyword(key), indentCnt == 0 && routeSpecificKeys[key]:
+		keyStyle = dp.Theme.Route.Key
+	case indentCnt > 0:
+		// the fields under "Ingress:" are shown as the second level regardless of their indentation
+		keyStyle = getColorByKeyIndent(describeIndentWidth, describeIndentWidth, dp.Theme)
+	}
+
+	fmt.Fprintf(w, "%s%s%s%s\n", indent, dp.colorizeKey(key, keyStyle), separator, dp.colorizeRouteValue(strings.TrimSuffix(key, ":"), value))
+}
+
+func isRouteDetectionKeyword(key string) bool {
+	for _, keyword := range routeDetectionKeywords {
+		if key == keyword {
+			return true
+		}
+	}
+
+	return false
+}
+
+// colorizeRouteValue colors the value of the route field key.
+func (dp *DescribePrinter) colorizeRouteValue(key, value string) string {
+	// e.g. "my-service (100%)" is split into "my-service" and "(100%)"
+	firstWord, rest := value, ""
+	if i := strings.Index(value, " "); i != -1 {
+		firstWord, rest = value[:i], value[i+1:]
+	}
+
+	colorizeRest := func(colored string) string {
+		if rest == "" {
+			return colored
 		}
+		return colored + " " + color.Apply(rest, getColorByValueType(rest, dp.Theme))
+	}
 
-		// Default key-value printing if no special route handling took over or if not a route
-		fmt.Fprintf(w, "%s%s%s%s\n", indent, coloredKeyOutput, toSpaces(spacesCnt), color.Apply(valueOutput, effectiveValColor))
+	switch key {
+	case "Name", "Requested Host", "Host", "Service":
+		return colorizeRest(color.Apply(firstWord, dp.Theme.Route.ResourceName))
+	case "Endpoints":
+		endpoints := strings.Split(value, ",")
+		colored := make([]string, len(endpoints))
+		for i, ep := range endpoints {
+			colored[i] = color.Apply(strings.TrimSpace(ep), dp.Theme.Route.Endpoint)
+		}
+		return strings.Join(colored, color.Apply(",", dp.Theme.Route.Comma)+" ")
+	case "TLS Termination":
+		style := getColorByValueType(firstWord, dp.Theme)
+		switch strings.ToLower(firstWord) {
+		case "edge":
+			style = dp.Theme.Route.TLSEdge
+		case "passthrough":
+			style = dp.Theme.Route.TLSPassthrough
+		case "reencrypt":
+			style = dp.Theme.Route.TLSReencrypt
+		}
+		return colorizeRest(color.Apply(firstWord, style))
 	}
+
+	return color.Apply(value, getColorByValueType(value, dp.Theme))
 }
@@ -1,7 +1,9 @@
 package printer
 
 import (
+	"bufio"
 	"bytes"
+	"io"
 	"strings"
 	"testing"
 
@@ -13,6 +15,7 @@ func Test_DescribePrinter_Print(t *testing.T) {
 		name           string
 		darkBackground bool
 		tablePrinter   *TablePrinter
+		isRoute        bool
 		input          string
 		expected       string
 	}{
@@ -67,7 +70,7 @@ func Test_DescribePrinter_Print(t *testing.T) {
 		{
 			name:           "table format in kubectl describe can be colored by describe",
 			darkBackground: true,
-			tablePrinter:   NewTablePrinter(false, true, nil),
+			tablePrinter:   NewTablePrinter(false, DarkTheme(), nil),
 			input: testutil.NewHereDoc(`
 				Conditions:
 				  Type             Status  LastHeartbeatTime                 LastTransitionTime                Reason                       Message
@@ -128,7 +131,7 @@ func Test_DescribePrinter_Print(t *testing.T) {
 				[36m[0m  [32mdefault[0m                     [35mnginx-6799fc88d8-m8pbc[0m              [37m0 (0%)[0m        [33m0 (0%)[0m      [36m0 (0%)[0m           [32m0 (0%)[0m         [32m7d21h[0m
 				[36m[0m  [32mdefault[0m                     [35mnginx-6799fc88d8-qdf9b[0m              [37m0 (0%)[0m        [33m0 (0%)[0m      [36m0 (0%)[0m           [32m0 (0%)[0m         [32m7d21h[0m
 				[33mAllocated resources[0m:
-				  [36m(Total limits may be over 100 percent, i.e., overcommitted.)[0m
+				  [37m(Total limits may be over 100 percent, i.e., overcommitted.)[0m
 				[36m[0m  [32mResource[0m           [35mRequests[0m    [37mLimits[0m
 				[36m[0m  [32m--------[0m           [35m--------[0m    [37m------[0m
 				[36m[0m  [32mcpu[0m                [35m650m (10%)[0m  [37m0 (0%)[0m
@@ -146,7 +149,7 @@ func Test_DescribePrinter_Print(t *testing.T) {
 			// For more details, see the PR description on GitHub.
 			name:           "invalid test for the workaround",
 			darkBackground: true,
-			tablePrinter:   NewTablePrinter(false, true, nil),
+			tablePrinter:   NewTablePrinter(false, DarkTheme(), nil),
 			input: testutil.NewHereDoc(`
 				Name:         default
 				Labels:       <none>
@@ -167,9 +170,9 @@ func Test_DescribePrinter_Print(t *testing.T) {
 				[33mName[0m:         [36mdefault[0m
 				[33mLabels[0m:       [33m<none>[0m
 				[33mAnnotations[0m:  [33m<none>[0m
-				[33mStatus[0m:       [36mActive[0m
+				[33mStatus[0m:       [32mActive[0m
 				
-				[36mResource Quotas[0m
+				[33mResource Quotas[0m
 				 [33mName[0m:            [36mmem-cpu-quota[0m
 				[36m Resource[0m         [32mUsed[0m  [35mHard[0m
 				[36m --------[0m         [32m---[0m   [35m---[0m
@@ -178,13 +181,14 @@ func Test_DescribePrinter_Print(t *testing.T) {
 				[36m requests.cpu[0m     [32m0[0m     [35m1[0m
 				[36m requests.memory[0m  [32m0[0m     [35m1Gi[0m
 				
-				[36mNo LimitRange resource.[0m
+				[33mNo LimitRange resource.[0m
 			`),
 		},
 		{
 			name:           "oc describe route",
 			darkBackground: true,
-			tablePrinter:   NewTablePrinter(false, true, nil), // Assuming some parts might be table-like or for consistency
+			isRoute:        true,
+			tablePrinter:   NewTablePrinter(false, DarkTheme(), nil), // Assuming some parts might be table-like or for consistency
 			input: testutil.NewHereDoc(`
 Name:           my-route
 Namespace:      my-project
@@ -212,7 +216,7 @@ Ingress:        (subdomain)/my-route admitted by router-1 (host router-1.example
 [33mTLS Termination[0m: [34medge[0m [36m(passthrough is also an option)[0m
 [33mService[0m:        [32mmy-service[0m [36m(100%)[0m
 [33mWeight[0m:         [35m100[0m
-[33mEndpoints[0m:      [36m10.128.0.1:8080[0m[97m,[0m [36m10.128.0.2:8080[0m
+[33mEndpoints[0m:      [36m10.128.0.1:8080[0m[37m,[0m [36m10.128.0.2:8080[0m
 [33mIngress[0m:        [36m(subdomain)/my-route admitted by router-1 (host router-1.example-apps.com)[0m
   [37mService[0m: [32mmy-service[0m [36m(10.128.0.1:8080,10.128.0.2:8080)[0m
     [37mHost[0m: [32mwww.example.com[0m [36m(my-route) (serves all traffic)[0m
@@ -220,6 +224,46 @@ Ingress:        (subdomain)/my-route admitted by router-1 (host router-1.example
     [33mTLS Termination[0m: [33mreencrypt[0m
 `),
 		},
+		{
+			name:           "a route is found by its fields when the command doesn't tell it",
+			darkBackground: true,
+			tablePrinter:   NewTablePrinter(false, DarkTheme(), nil),
+			input: testutil.NewHereDoc(`
+				Name:           my-route
+				Requested Host: www.example.com
+				Service:        my-service (100%)`),
+			expected: testutil.NewHereDoc(`
+				[33mName[0m:           [36mmy-route[0m
+				[33mRequested Host[0m: [32mwww.example.com[0m
+				[33mService[0m:        [32mmy-service[0m [36m(100%)[0m
+			`),
+		},
+		{
+			name:           "statuses are colored by their severities",
+			darkBackground: true,
+			tablePrinter:   NewTablePrinter(false, ThemeForBackground(true), statusDeciderFn(ThemeForBackground(true), nil)),
+			input: testutil.NewHereDoc(`
+				Status:       Terminating (lasts 2m)
+				Containers:
+				  nginx:
+				    State:          Waiting
+				      Reason:       CrashLoopBackOff
+				Events:
+				  Type     Reason   Age   From     Message
+				  ----     ------   ----  ----     -------
+				  Warning  BackOff  5s    kubelet  Back-off restarting failed container`),
+			expected: testutil.NewHereDoc(`
+				[33mStatus[0m:       [33mTerminating[0m [36m(lasts 2m)[0m
+				[33mContainers[0m:
+				  [37mnginx[0m:
+				    [33mState[0m:          [33mWaiting[0m
+				      [37mReason[0m:       [31mCrashLoopBackOff[0m
+				[33mEvents[0m:
+				[36m[0m  [32mType[0m     [35mReason[0m   [37mAge[0m   [33mFrom[0m     [36mMessage[0m
+				[36m[0m  [32m----[0m     [35m------[0m   [37m----[0m  [33m----[0m     [36m-------[0m
+				[36m[0m  [33mWarning[0m  [31mBackOff[0m  [37m5s[0m    [33mkubelet[0m  [36mBack-off restarting failed container[0m
+			`),
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -227,9 +271,50 @@ Ingress:        (subdomain)/my-route admitted by router-1 (host router-1.example
 			t.Parallel()
 			r := strings.NewReader(tt.input)
 			var w bytes.Buffer
-			printer := DescribePrinter{DarkBackground: tt.darkBackground, TablePrinter: tt.tablePrinter}
+			printer := DescribePrinter{Theme: ThemeForBackground(tt.darkBackground), TablePrinter: tt.tablePrinter, IsRoute: tt.isRoute}
 			printer.Print(r, &w)
 			testutil.MustEqual(t, tt.expected, w.String())
 		})
 	}
 }
+
+func Test_DescribePrinter_Print_Streaming(t *testing.T) {
+	r, input := io.Pipe()
+	output, w := io.Pipe()
+	printer := DescribePrinter{Theme: DarkTheme(), TablePrinter: NewTablePrinter(false, DarkTheme(), nil)}
+	go func() {
+		printer.Print(r, w)
+		w.Close()
+	}()
+
+	// the line must be shown before the rest of the input comes
+	go input.Write([]byte("Name:         nginx\n"))
+	line, err := bufio.NewReader(output).ReadString('\n')
+	if err != nil {
+		t.Fatal(err)
+	}
+	testutil.MustEqual(t, "\x1b[33mName\x1b[0m:         \x1b[36mnginx\x1b[0m\n", line)
+	input.Close()
+}
+
+func Test_isRouteResource(t *testing.T) {
+	tests := []struct {
+		positionals []string
+		expected    bool
+	}{
+		{[]string{"route", "my-route"}, true},
+		{[]string{"routes"}, true},
+		{[]string{"route/my-route"}, true},
+		{[]string{"routes.route.openshift.io", "my-route"}, true},
+		{[]string{"pod", "route"}, false},
+		{[]string{"routers"}, false},
+		{nil, false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(strings.Join(tt.positionals, " "), func(t *testing.T) {
+			t.Parallel()
+			testutil.MustEqual(t, tt.expected, isRouteResource(tt.positionals))
+		})
+	}
+}
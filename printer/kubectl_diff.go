@@ -0,0 +1,251 @@
+package printer
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hidetatz/kubecolor/color"
+)
+
+// diffHunkHeader is the header of a hunk in unified diff, e.g. "@@ -6,7 +6,7 @@".
+// The line counts are omitted when they are 1.
+var diffHunkHeader = regexp.MustCompile(`^@@ -\d+(?:,(\d+))? \+\d+(?:,(\d+))? @@`)
+
+// diffWord is a unit to compare changed lines by: a word, a run of spaces or a symbol.
+var diffWord = regexp.MustCompile(`\w+|\s+|[^\w\s]`)
+
+// maxDiffWordsProduct limits the size of the table to find the changed words, so that long lines don't slow it down.
+const maxDiffWordsProduct = 10000
+
+// DiffPrinter is a printer for kubectl diff, which shows unified diff.
+// e.g.
+//
+//	diff -u -N /tmp/LIVE-2837/apps.v1.Deployment.default.nginx /tmp/MERGED-1733/apps.v1.Deployment.default.nginx
+//	--- /tmp/LIVE-2837/apps.v1.Deployment.default.nginx	2023-01-02 15:04:05.000000000 +0900
+//	+++ /tmp/MERGED-1733/apps.v1.Deployment.default.nginx	2023-01-02 15:04:05.000000000 +0900
+//	@@ -6,7 +6,7 @@
+//	   name: nginx
+//	 spec:
+//	-  replicas: 1
+//	+  replicas: 3
+//	   selector:
+//
+// When removed lines are followed by the same number of added lines,
+// they are compared in pairs and the changed words are highlighted.
+type DiffPrinter struct {
+	Theme *Theme
+}
+
+func (dp *DiffPrinter) Print(r io.Reader, w io.Writer) {
+	// the lines left in the current hunk. Lines in a hunk can look like headers, e.g. a removed "-- " line,
+	// so the hunk is told by the line counts in its header.
+	oldLeft, newLeft := 0, 0
+
+	// the changed lines waiting for their counterparts
+	var removed, added []string
+	flush := func() {
+		dp.printChanges(w, removed, added)
+		removed, added = nil, nil
+	}
+
+	printLine := func(line string) {
+		if strings.HasPrefix(line, `\`) { // "\ No newline at end of file"
+			flush()
+			fmt.Fprintf(w, "%s\n", line)
+			return
+		}
+
+		if oldLeft > 0 || newLeft > 0 {
+			switch {
+			case strings.HasPrefix(line, "-"):
+				if len(added) > 0 {
+					flush()
+				}
+				removed = append(removed, line)
+				oldLeft--
+			case strings.HasPrefix(line, "+"):
+				added = append(added, line)
+				newLeft--
+			default: // context
+				flush()
+				fmt.Fprintf(w, "%s\n", line)
+				oldLeft--
+				newLeft--
+			}
+			return
+		}
+
+		flush()
+
+		if m := diffHunkHeader.FindStringSubmatch(line); m != nil {
+			oldLeft, newLeft = diffLineCount(m[1]), diffLineCount(m[2])
+			// the text after the header is the section heading, e.g. "@@ -1,2 +1,2 @@ func main()"
+			fmt.Fprintf(w, "%s%s\n", color.Apply(m[0], dp.Theme.Diff.Hunk), line[len(m[0]):])
+			return
+		}
+
+		fmt.Fprintf(w, "%s\n", color.Apply(line, dp.Theme.Diff.Header))
+	}
+
+	// bufio.Scanner is not used because a line can be longer than its max token size,
+	// e.g. the last-applied-configuration annotation of a large resource.
+	br := bufio.NewReader(r)
+	for {
+		line, err := br.ReadString('\n')
+		if line != "" {
+			printLine(strings.TrimSuffix(line, "\n"))
+		}
+		if err != nil {
+			break
+		}
+	}
+
+	flush()
+}
+
+// diffLineCount returns the line count in a hunk header, which is 1 when omitted.
+func diffLineCount(s string) int {
+	if s == "" {
+		return 1
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0
+	}
+
+	return n
+}
+
+// printChanges prints removed lines then added lines.
+// When they have the same number of lines, each pair of them gets its changed words highlighted.
+func (dp *DiffPrinter) printChanges(w io.Writer, removed, added []string) {
+	if len(removed) != len(added) {
+		for _, line := range removed {
+			fmt.Fprintf(w, "%s\n", color.Apply(line, dp.Theme.Diff.Removed))
+		}
+		for _, line := range added {
+			fmt.Fprintf(w, "%s\n", color.Apply(line, dp.Theme.Diff.Added))
+		}
+		return
+	}
+
+	addedLines := make([]string, len(added))
+	for i := range removed {
+		r, a := dp.highlightChangedWords(removed[i], added[i])
+		fmt.Fprintf(w, "%s\n", r)
+		addedLines[i] = a
+	}
+	for _, line := range addedLines {
+		fmt.Fprintf(w, "%s\n", line)
+	}
+}
+
+// highlightChangedWords returns the colored removed and added lines with their changed words highlighted.
+func (dp *DiffPrinter) highlightChangedWords(removed, added string) (string, string) {
+	theme := dp.Theme.Diff
+	oldWords := diffWord.FindAllString(removed[1:], -1)
+	newWords := diffWord.FindAllString(added[1:], -1)
+	if len(oldWords)*len(newWords) > maxDiffWordsProduct {
+		return color.Apply(removed, theme.Removed), color.Apply(added, theme.Added)
+	}
+
+	oldChanged, newChanged := changedWords(oldWords, newWords)
+
+	// when nothing but spaces is common, highlighting is just noise
+	if !hasUnchangedWord(oldWords, oldChanged) {
+		return color.Apply(removed, theme.Removed), color.Apply(added, theme.Added)
+	}
+
+	return joinDiffWords("-", oldWords, oldChanged, theme.Removed, theme.RemovedHighlight),
+		joinDiffWords("+", newWords, newChanged, theme.Added, theme.AddedHighlight)
+}
+
+// changedWords compares a and b by their longest common subsequence
+// and reports which words are not in it.
+func changedWords(a, b []string) (aChanged, bChanged []bool) {
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	aChanged, bChanged = make([]bool, len(a)), make([]bool, len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			aChanged[i] = true
+			i++
+		default:
+			bChanged[j] = true
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		aChanged[i] = true
+	}
+	for ; j < len(b); j++ {
+		bChanged[j] = true
+	}
+
+	return aChanged, bChanged
+}
+
+func hasUnchangedWord(words []string, changed []bool) bool {
+	for i, word := range words {
+		if !changed[i] && strings.TrimSpace(word) != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// joinDiffWords colors words after marker, highlighting the changed ones.
+// The words next to each other in the same color are colored at once.
+func joinDiffWords(marker string, words []string, changed []bool, base, highlight color.Style) string {
+	var b strings.Builder
+	run := marker
+	runChanged := false
+	for i, word := range words {
+		if changed[i] != runChanged {
+			b.WriteString(diffRun(run, runChanged, base, highlight))
+			run, runChanged = "", changed[i]
+		}
+		run += word
+	}
+	b.WriteString(diffRun(run, runChanged, base, highlight))
+
+	return b.String()
+}
+
+func diffRun(run string, changed bool, base, highlight color.Style) string {
+	if run == "" {
+		return ""
+	}
+	if changed {
+		return color.Apply(run, highlight)
+	}
+
+	return color.Apply(run, base)
+}
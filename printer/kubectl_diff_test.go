@@ -0,0 +1,119 @@
+package printer
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/hidetatz/kubecolor/testutil"
+)
+
+func Test_DiffPrinter_Print(t *testing.T) {
+	tests := []struct {
+		name           string
+		darkBackground bool
+		input          string
+		expected       string
+	}{
+		{
+			name:           "headers, hunk and context lines",
+			darkBackground: true,
+			input: testutil.NewHereDoc(`
+				diff -u -N /tmp/LIVE-1/apps.v1.Deployment.default.nginx /tmp/MERGED-1/apps.v1.Deployment.default.nginx
+				--- /tmp/LIVE-1/apps.v1.Deployment.default.nginx	2023-01-02 15:04:05.000000000 +0900
+				+++ /tmp/MERGED-1/apps.v1.Deployment.default.nginx	2023-01-02 15:04:05.000000000 +0900
+				@@ -6,3 +6,4 @@ metadata
+				   name: nginx
+				 spec:
+				+  paused: true
+				   replicas: 1`),
+			expected: testutil.NewHereDoc(`
+				[1mdiff -u -N /tmp/LIVE-1/apps.v1.Deployment.default.nginx /tmp/MERGED-1/apps.v1.Deployment.default.nginx[0m
+				[1m--- /tmp/LIVE-1/apps.v1.Deployment.default.nginx	2023-01-02 15:04:05.000000000 +0900[0m
+				[1m+++ /tmp/MERGED-1/apps.v1.Deployment.default.nginx	2023-01-02 15:04:05.000000000 +0900[0m
+				[36m@@ -6,3 +6,4 @@[0m metadata
+				   name: nginx
+				 spec:
+				[32m+  paused: true[0m
+				   replicas: 1
+			`),
+		},
+		{
+			name:           "changed words are highlighted",
+			darkBackground: true,
+			input: testutil.NewHereDoc(`
+				@@ -1,3 +1,3 @@
+				 spec:
+				-  replicas: 1
+				+  replicas: 3
+				   selector:`),
+			expected: testutil.NewHereDoc(`
+				[36m@@ -1,3 +1,3 @@[0m
+				 spec:
+				[31m-  replicas: [0m[7;31m1[0m
+				[32m+  replicas: [0m[7;32m3[0m
+				   selector:
+			`),
+		},
+		{
+			name:           "blocks of different sizes are not compared",
+			darkBackground: false,
+			input: testutil.NewHereDoc(`
+				@@ -1 +1,2 @@
+				-  image: nginx:1.14
+				+  image: nginx:1.16
+				+  imagePullPolicy: Always`),
+			expected: testutil.NewHereDoc(`
+				[34m@@ -1 +1,2 @@[0m
+				[31m-  image: nginx:1.14[0m
+				[32m+  image: nginx:1.16[0m
+				[32m+  imagePullPolicy: Always[0m
+			`),
+		},
+		{
+			name:           "lines which have nothing in common are not highlighted",
+			darkBackground: true,
+			input: testutil.NewHereDoc(`
+				@@ -1 +1 @@
+				-foo
+				+bar`),
+			expected: testutil.NewHereDoc(`
+				[36m@@ -1 +1 @@[0m
+				[31m-foo[0m
+				[32m+bar[0m
+			`),
+		},
+		{
+			name:           "lines looking like headers in a hunk",
+			darkBackground: true,
+			input: testutil.NewHereDoc(`
+				@@ -1,2 +1,1 @@
+				---- a
+				 b
+				diff -u -N /tmp/LIVE-1/v1.ConfigMap.default.c /tmp/MERGED-1/v1.ConfigMap.default.c`),
+			expected: testutil.NewHereDoc(`
+				[36m@@ -1,2 +1,1 @@[0m
+				[31m---- a[0m
+				 b
+				[1mdiff -u -N /tmp/LIVE-1/v1.ConfigMap.default.c /tmp/MERGED-1/v1.ConfigMap.default.c[0m
+			`),
+		},
+		{
+			name:           "a line longer than 64KB doesn't stop the diff",
+			darkBackground: true,
+			input:          "@@ -1,2 +1,2 @@\n-" + strings.Repeat("a", 70000) + "\n+" + strings.Repeat("b", 70000) + "\n c\n",
+			expected:       "\x1b[36m@@ -1,2 +1,2 @@\x1b[0m\n\x1b[31m-" + strings.Repeat("a", 70000) + "\x1b[0m\n\x1b[32m+" + strings.Repeat("b", 70000) + "\x1b[0m\n c\n",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := strings.NewReader(tt.input)
+			var w bytes.Buffer
+			printer := DiffPrinter{Theme: ThemeForBackground(tt.darkBackground)}
+			printer.Print(r, &w)
+			testutil.MustEqual(t, tt.expected, w.String())
+		})
+	}
+}
@@ -162,7 +162,7 @@ func Test_ExplainPrinter_Print(t *testing.T) {
 			t.Parallel()
 			r := strings.NewReader(tt.input)
 			var w bytes.Buffer
-			printer := ExplainPrinter{DarkBackground: tt.darkBackground, Recursive: tt.recursive}
+			printer := ExplainPrinter{Theme: ThemeForBackground(tt.darkBackground), Recursive: tt.recursive}
 			printer.Print(r, &w)
 			testutil.MustEqual(t, tt.expected, w.String())
 		})
@@ -0,0 +1,215 @@
+package printer
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/hidetatz/kubecolor/color"
+)
+
+// LogFormat is how the lines of kubectl logs are parsed.
+type LogFormat string
+
+const (
+	// LogFormatAuto finds klog and logfmt lines and colors their fields. It's the default.
+	LogFormatAuto LogFormat = "auto"
+	// LogFormatPlain prints the lines as they are, with their log levels colored.
+	LogFormatPlain LogFormat = "plain"
+	// LogFormatJSON pretty-prints the lines written as JSON objects.
+	LogFormatJSON LogFormat = "json"
+	// LogFormatKlog colors the fields of klog lines.
+	LogFormatKlog LogFormat = "klog"
+	// LogFormatLogfmt colors the fields of logfmt lines.
+	LogFormatLogfmt LogFormat = "logfmt"
+)
+
+type logLevel int
+
+const (
+	logLevelNone logLevel = iota
+	logLevelTrace
+	logLevelDebug
+	logLevelInfo
+	logLevelWarn
+	logLevelError
+	logLevelFatal
+)
+
+var (
+	// logPrefix is the prefix which kubectl logs --prefix adds to the head of each line, e.g. "[pod/nginx-6799fc88d8-dnmv5/nginx] "
+	logPrefix = regexp.MustCompile(`^\[[^\]\s]+/[^\]\s]+\] `)
+
+	// logTimestamp is the timestamp which kubectl logs --timestamps adds to the head of each line,
+	// e.g. "2023-01-02T15:04:05.123456789Z "
+	logTimestamp = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2}) `)
+
+	// klogHeader is the head of klog lines, e.g. "I0102 15:04:05.123456" in
+	// "I0102 15:04:05.123456       1 main.go:10] message"
+	klogHeader = regexp.MustCompile(`^([IWEF])(\d{4} \d{2}:\d{2}:\d{2}\.\d{6})`)
+
+	// logLevelWord is the level written in a log line, e.g. "ERROR" in "2023/01/02 15:04:05 [ERROR] message"
+	logLevelWord = regexp.MustCompile(`\b(FATAL|PANIC|ERROR|ERR|WARNING|WARN|INFO|DEBUG|TRACE)\b`)
+
+	// stackTraceLine is a line continuing the log entry above, like stack traces of Java, Go and Python.
+	// e.g. "\tat com.example.App.main(App.java:5)", "java.io.IOException: closed", "main.main()"
+	stackTraceLine = regexp.MustCompile(`^(\s|at |Caused by: |\.\.\. \d+ more|([\w$]+\.)+[\w$]*(Exception|Error)(: |$)|goroutine \d+ \[|Traceback \(most recent call last\):|[\w.*/()-]+\(.*\)$)`)
+)
+
+// the keys commonly used by structured loggers (zap, logrus, slog, etc.) for the well-known fields
+var (
+	logTimestampKeys = []string{"time", "ts", "timestamp", "@timestamp"}
+	logLevelKeys     = []string{"level", "lvl", "severity"}
+	logMessageKeys   = []string{"msg", "message"}
+)
+
+var logLevelWords = map[string]logLevel{
+	"FATAL":   logLevelFatal,
+	"PANIC":   logLevelFatal,
+	"ERROR":   logLevelError,
+	"ERR":     logLevelError,
+	"WARNING": logLevelWarn,
+	"WARN":    logLevelWarn,
+	"INFO":    logLevelInfo,
+	"DEBUG":   logLevelDebug,
+	"TRACE":   logLevelTrace,
+}
+
+var klogLevels = map[string]logLevel{
+	"I": logLevelInfo,
+	"W": logLevelWarn,
+	"E": logLevelError,
+	"F": logLevelFatal,
+}
+
+// LogsPrinter is a printer for kubectl logs.
+// It colors the log level of each line and the timestamp added by --timestamps.
+// The prefix added by --prefix gets a color picked by the pod and container name, which stays the same between runs.
+// The lines continuing a log entry such as stack traces are shown in the level color of the entry.
+// Messages are printed as they are, so they stay readable whatever the application writes.
+// The fields of klog and logfmt lines are colored by default, and Format can force one of them.
+// When Format is LogFormatJSON, JSON lines are shown as "<timestamp> <level> <message> key=value ...".
+type LogsPrinter struct {
+	Theme  *Theme
+	Format LogFormat
+}
+
+func (lp *LogsPrinter) Print(r io.Reader, w io.Writer) {
+	// the level of the last log entry which the following stack trace lines belong to.
+	// It's kept per prefix because the lines of the containers are interleaved with --prefix.
+	entryLevels := map[string]logLevel{}
+
+	// bufio.Scanner is not used because a log line can be longer than its max token size.
+	// Each line is written as soon as it's read, so logs -f shows them without delay.
+	br := bufio.NewReader(r)
+	for {
+		line, err := br.ReadString('\n')
+		if line != "" {
+			line = strings.TrimSuffix(line, "\n")
+			prefix := lp.printPrefix(w, line)
+			entryLevels[prefix] = lp.printLine(w, line[len(prefix):], entryLevels[prefix])
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// printPrefix prints the prefix of line added by --prefix and returns it. It returns "" when line has no prefix.
+func (lp *LogsPrinter) printPrefix(w io.Writer, line string) string {
+	prefix := logPrefix.FindString(line)
+	if prefix != "" {
+		name := strings.TrimSuffix(prefix, " ")
+		fmt.Fprintf(w, "%s ", color.Apply(name, stableColor(name, lp.Theme.Table)))
+	}
+
+	return prefix
+}
+
+// printLine prints a log line and returns the level of the log entry the line belongs to.
+func (lp *LogsPrinter) printLine(w io.Writer, line string, entryLevel logLevel) logLevel {
+	if ts := logTimestamp.FindString(line); ts != "" {
+		fmt.Fprintf(w, "%s ", color.Apply(strings.TrimSuffix(ts, " "), lp.Theme.Logs.Timestamp))
+		line = line[len(ts):]
+	}
+
+	// blank lines can be in a stack trace, e.g. between "panic:" and "goroutine 1 [running]:"
+	if strings.TrimSpace(line) == "" {
+		fmt.Fprintf(w, "%s\n", line)
+		return entryLevel
+	}
+
+	if entryLevel != logLevelNone && stackTraceLine.MatchString(line) {
+		fmt.Fprintf(w, "%s\n", color.Apply(line, lp.levelStyle(entryLevel)))
+		return entryLevel
+	}
+
+	if level, ok := lp.printStructuredLine(w, line); ok {
+		return level
+	}
+
+	if m := klogHeader.FindStringSubmatch(line); m != nil {
+		level := klogLevels[m[1]]
+		fmt.Fprintf(w, "%s%s%s\n", color.Apply(m[1], lp.levelStyle(level)), color.Apply(m[2], lp.Theme.Logs.Timestamp), line[len(m[0]):])
+		return level
+	}
+
+	// Go's panic message, e.g. "panic: runtime error: invalid memory address or nil pointer dereference"
+	if strings.HasPrefix(line, "panic: ") {
+		fmt.Fprintf(w, "%s%s\n", color.Apply("panic", lp.levelStyle(logLevelFatal)), strings.TrimPrefix(line, "panic"))
+		return logLevelFatal
+	}
+
+	if loc := logLevelWord.FindStringIndex(line); loc != nil {
+		word := line[loc[0]:loc[1]]
+		level := logLevelWords[word]
+		fmt.Fprintf(w, "%s%s%s\n", line[:loc[0]], color.Apply(word, lp.levelStyle(level)), line[loc[1]:])
+		return level
+	}
+
+	fmt.Fprintf(w, "%s\n", line)
+	return logLevelNone
+}
+
+// printStructuredLine prints line when it's written in the structured format lp.Format tells.
+// ok is false when it's not, then nothing is printed.
+func (lp *LogsPrinter) printStructuredLine(w io.Writer, line string) (level logLevel, ok bool) {
+	switch lp.Format {
+	case LogFormatJSON:
+		return lp.printJSONLine(w, line)
+	case LogFormatKlog:
+		return lp.printKlogLine(w, line)
+	case LogFormatLogfmt:
+		return lp.printLogfmtLine(w, line)
+	case LogFormatPlain:
+		return logLevelNone, false
+	}
+
+	// auto
+	if level, ok := lp.printKlogLine(w, line); ok {
+		return level, true
+	}
+
+	return lp.printLogfmtLine(w, line)
+}
+
+func (lp *LogsPrinter) levelStyle(level logLevel) color.Style {
+	switch level {
+	case logLevelFatal:
+		return lp.Theme.Logs.Fatal
+	case logLevelError:
+		return lp.Theme.Logs.Error
+	case logLevelWarn:
+		return lp.Theme.Logs.Warn
+	case logLevelInfo:
+		return lp.Theme.Logs.Info
+	case logLevelDebug:
+		return lp.Theme.Logs.Debug
+	case logLevelTrace:
+		return lp.Theme.Logs.Trace
+	}
+
+	return color.Style{}
+}
@@ -0,0 +1,295 @@
+package printer
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/hidetatz/kubecolor/testutil"
+)
+
+func Test_LogsPrinter_Print(t *testing.T) {
+	tests := []struct {
+		name           string
+		darkBackground bool
+		input          string
+		expected       string
+	}{
+		{
+			name:           "levels are colored",
+			darkBackground: true,
+			input: testutil.NewHereDoc(`
+				2023/01/02 15:04:05 [INFO] server started
+				2023/01/02 15:04:06 [WARN] slow request
+				2023/01/02 15:04:07 [ERROR] request failed
+				2023/01/02 15:04:08 DEBUG cache hit
+				2023/01/02 15:04:09 TRACE entering handler
+				2023/01/02 15:04:10 FATAL cannot continue`),
+			expected: testutil.NewHereDoc(`
+				2023/01/02 15:04:05 [[32mINFO[0m] server started
+				2023/01/02 15:04:06 [[33mWARN[0m] slow request
+				2023/01/02 15:04:07 [[31mERROR[0m] request failed
+				2023/01/02 15:04:08 [35mDEBUG[0m cache hit
+				2023/01/02 15:04:09 [37mTRACE[0m entering handler
+				2023/01/02 15:04:10 [1;31mFATAL[0m cannot continue
+			`),
+		},
+		{
+			name:           "lines without level are printed as is",
+			darkBackground: true,
+			input: testutil.NewHereDoc(`
+				Listening on :8080
+				more info is in the docs`),
+			expected: testutil.NewHereDoc(`
+				Listening on :8080
+				more info is in the docs
+			`),
+		},
+		{
+			name:           "klog",
+			darkBackground: true,
+			input: testutil.NewHereDoc(`
+				I0102 15:04:05.123456       1 main.go:10] Starting controller
+				W0102 15:04:06.123456       1 main.go:20] Retrying
+				E0102 15:04:07.123456       1 main.go:30] Failed to sync`),
+			expected: testutil.NewHereDoc(`
+				[32mI[0m[36m0102 15:04:05.123456[0m       1 [2mmain.go:10[0m] [1mStarting controller[0m
+				[33mW[0m[36m0102 15:04:06.123456[0m       1 [2mmain.go:20[0m] [1mRetrying[0m
+				[31mE[0m[36m0102 15:04:07.123456[0m       1 [2mmain.go:30[0m] [1mFailed to sync[0m
+			`),
+		},
+		{
+			name:           "--timestamps",
+			darkBackground: true,
+			input: testutil.NewHereDoc(`
+				2023-01-02T15:04:05.123456789Z INFO server started
+				2023-01-02T15:04:06.123456789+09:00 done`),
+			expected: testutil.NewHereDoc(`
+				[36m2023-01-02T15:04:05.123456789Z[0m [32mINFO[0m server started
+				[36m2023-01-02T15:04:06.123456789+09:00[0m done
+			`),
+		},
+		{
+			name:           "--prefix gets a color per pod and container",
+			darkBackground: true,
+			input: testutil.NewHereDoc(`
+				[pod/api-0/api] 2023-01-02T15:04:05Z ERROR boom
+				[pod/api-1/api] 2023-01-02T15:04:05Z INFO ok
+				[pod/api-0/api] 2023-01-02T15:04:05Z 	at com.example.App.main(App.java:5)
+				[pod/api-1/api] 2023-01-02T15:04:06Z done
+				[pod/nginx-6799fc88d8-m8pbc/sidecar] ready`),
+			expected: testutil.NewHereDoc(`
+				[32m[pod/api-0/api][0m [36m2023-01-02T15:04:05Z[0m [31mERROR[0m boom
+				[35m[pod/api-1/api][0m [36m2023-01-02T15:04:05Z[0m [32mINFO[0m ok
+				[32m[pod/api-0/api][0m [36m2023-01-02T15:04:05Z[0m [31m	at com.example.App.main(App.java:5)[0m
+				[35m[pod/api-1/api][0m [36m2023-01-02T15:04:06Z[0m done
+				[36m[pod/nginx-6799fc88d8-m8pbc/sidecar][0m ready
+			`),
+		},
+		{
+			name:           "java stack trace belongs to the error",
+			darkBackground: true,
+			input: testutil.NewHereDoc(`
+				ERROR Unhandled exception
+				java.lang.IllegalStateException: boom
+					at com.example.App.run(App.java:10)
+					at com.example.App.main(App.java:5)
+				Caused by: java.io.IOException: closed
+					... 2 more
+				INFO recovered`),
+			expected: testutil.NewHereDoc(`
+				[31mERROR[0m Unhandled exception
+				[31mjava.lang.IllegalStateException: boom[0m
+				[31m	at com.example.App.run(App.java:10)[0m
+				[31m	at com.example.App.main(App.java:5)[0m
+				[31mCaused by: java.io.IOException: closed[0m
+				[31m	... 2 more[0m
+				[32mINFO[0m recovered
+			`),
+		},
+		{
+			name:           "go panic",
+			darkBackground: true,
+			input: testutil.NewHereDoc(`
+				panic: runtime error: invalid memory address or nil pointer dereference
+				
+				goroutine 1 [running]:
+				main.main()
+					/app/main.go:12 +0x1d`),
+			expected: testutil.NewHereDoc(`
+				[1;31mpanic[0m: runtime error: invalid memory address or nil pointer dereference
+				
+				[1;31mgoroutine 1 [running]:[0m
+				[1;31mmain.main()[0m
+				[1;31m	/app/main.go:12 +0x1d[0m
+			`),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := strings.NewReader(tt.input)
+			var w bytes.Buffer
+			printer := LogsPrinter{Theme: ThemeForBackground(tt.darkBackground)}
+			printer.Print(r, &w)
+			testutil.MustEqual(t, tt.expected, w.String())
+		})
+	}
+}
+
+func Test_LogsPrinter_Print_JSON(t *testing.T) {
+	tests := []struct {
+		name           string
+		darkBackground bool
+		input          string
+		expected       string
+	}{
+		{
+			name:           "timestamp, level and message come first",
+			darkBackground: true,
+			input: testutil.NewHereDoc(`
+				{"level":"info","ts":"2023-01-02T15:04:05Z","caller":"main.go:10","msg":"server started","port":8080}
+				{"time":"2023-01-02T15:04:06Z","level":"ERROR","msg":"request failed","path":"/api/v1","error":"connection refused","retry":true,"body":null}`),
+			expected: testutil.NewHereDoc(`
+				[36m2023-01-02T15:04:05Z[0m [32minfo[0m [1mserver started[0m [33mcaller[0m=[36mmain.go:10[0m [33mport[0m=[35m8080[0m
+				[36m2023-01-02T15:04:06Z[0m [31mERROR[0m [1mrequest failed[0m [33mpath[0m=[36m/api/v1[0m [33merror[0m=[36m"connection refused"[0m [33mretry[0m=[32mtrue[0m [33mbody[0m=[33mnull[0m
+			`),
+		},
+		{
+			name:           "nested values are shown in compact JSON",
+			darkBackground: true,
+			input: testutil.NewHereDoc(`
+				{"severity":"warning","message":"slow", "labels": {"app": "nginx"}, "ids": [1, 2]}`),
+			expected: testutil.NewHereDoc(`
+				[33mwarning[0m [1mslow[0m [33mlabels[0m=[36m{"app":"nginx"}[0m [33mids[0m=[36m[1,2][0m
+			`),
+		},
+		{
+			name:           "lines which are not JSON objects pass through",
+			darkBackground: true,
+			input: testutil.NewHereDoc(`
+				[INFO] not json
+				{"msg": "broken"
+				{"msg": "trailing"} text
+				["array"]`),
+			expected: testutil.NewHereDoc(`
+				[[32mINFO[0m] not json
+				{"msg": "broken"
+				{"msg": "trailing"} text
+				["array"]
+			`),
+		},
+		{
+			name:           "--timestamps",
+			darkBackground: true,
+			input: testutil.NewHereDoc(`
+				2023-01-02T15:04:05.123456789Z {"level":"debug","msg":"tick"}`),
+			expected: testutil.NewHereDoc(`
+				[36m2023-01-02T15:04:05.123456789Z[0m [35mdebug[0m [1mtick[0m
+			`),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := strings.NewReader(tt.input)
+			var w bytes.Buffer
+			printer := LogsPrinter{Theme: ThemeForBackground(tt.darkBackground), Format: LogFormatJSON}
+			printer.Print(r, &w)
+			testutil.MustEqual(t, tt.expected, w.String())
+		})
+	}
+}
+
+func Test_LogsPrinter_Print_Structured(t *testing.T) {
+	tests := []struct {
+		name           string
+		darkBackground bool
+		format         LogFormat
+		input          string
+		expected       string
+	}{
+		{
+			name:           "logfmt",
+			darkBackground: true,
+			input: testutil.NewHereDoc(`
+				time=2023-01-02T15:04:05Z level=warn msg="slow request" path=/api status=200 cached=false
+				level=error msg="escaped \"quote\"" err="connection refused"`),
+			expected: testutil.NewHereDoc(`
+				[33mtime[0m=[36m2023-01-02T15:04:05Z[0m [33mlevel[0m=[33mwarn[0m [33mmsg[0m=[1m"slow request"[0m [33mpath[0m=[36m/api[0m [33mstatus[0m=[35m200[0m [33mcached[0m=[32mfalse[0m
+				[33mlevel[0m=[31merror[0m [33mmsg[0m=[1m"escaped \"quote\""[0m [33merr[0m=[36m"connection refused"[0m
+			`),
+		},
+		{
+			name:           "a message containing = is not logfmt",
+			darkBackground: true,
+			input: testutil.NewHereDoc(`
+				INFO set replicas=3
+				debug=true`),
+			expected: testutil.NewHereDoc(`
+				[32mINFO[0m set replicas=3
+				debug=true
+			`),
+		},
+		{
+			name:           "structured klog",
+			darkBackground: true,
+			input: testutil.NewHereDoc(`
+				I0102 15:04:05.123456       1 controller.go:42] "Starting workers" controller="deployment" count=2
+				E0102 15:04:06.123456       1 controller.go:50] "Failed to sync" err="not found"`),
+			expected: testutil.NewHereDoc(`
+				[32mI[0m[36m0102 15:04:05.123456[0m       1 [2mcontroller.go:42[0m] [1m"Starting workers"[0m [33mcontroller[0m=[36m"deployment"[0m [33mcount[0m=[35m2[0m
+				[31mE[0m[36m0102 15:04:06.123456[0m       1 [2mcontroller.go:50[0m] [1m"Failed to sync"[0m [33merr[0m=[36m"not found"[0m
+			`),
+		},
+		{
+			name:           "forced klog doesn't parse logfmt",
+			darkBackground: true,
+			format:         LogFormatKlog,
+			input: testutil.NewHereDoc(`
+				level=info msg=hello
+				I0102 15:04:05.123456       1 main.go:10] hello`),
+			expected: testutil.NewHereDoc(`
+				level=info msg=hello
+				[32mI[0m[36m0102 15:04:05.123456[0m       1 [2mmain.go:10[0m] [1mhello[0m
+			`),
+		},
+		{
+			name:           "forced logfmt doesn't parse klog",
+			darkBackground: true,
+			format:         LogFormatLogfmt,
+			input: testutil.NewHereDoc(`
+				level=info msg=hello
+				I0102 15:04:05.123456       1 main.go:10] hello`),
+			expected: testutil.NewHereDoc(`
+				[33mlevel[0m=[32minfo[0m [33mmsg[0m=[1mhello[0m
+				[32mI[0m[36m0102 15:04:05.123456[0m       1 main.go:10] hello
+			`),
+		},
+		{
+			name:           "plain colors only the levels",
+			darkBackground: true,
+			format:         LogFormatPlain,
+			input: testutil.NewHereDoc(`
+				level=info msg=hello
+				I0102 15:04:05.123456       1 main.go:10] hello`),
+			expected: testutil.NewHereDoc(`
+				level=info msg=hello
+				[32mI[0m[36m0102 15:04:05.123456[0m       1 main.go:10] hello
+			`),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := strings.NewReader(tt.input)
+			var w bytes.Buffer
+			printer := LogsPrinter{Theme: ThemeForBackground(tt.darkBackground), Format: tt.format}
+			printer.Print(r, &w)
+			testutil.MustEqual(t, tt.expected, w.String())
+		})
+	}
+}
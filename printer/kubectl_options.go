@@ -10,7 +10,7 @@ import (
 )
 
 type OptionsPrinter struct {
-	DarkBackground bool
+	Theme *Theme
 }
 
 func (op *OptionsPrinter) Print(r io.Reader, w io.Writer) {
@@ -37,14 +37,10 @@ func (op *OptionsPrinter) Print(r io.Reader, w io.Writer) {
 		splitted := strings.SplitN(trimmedLine, ": ", 2)
 		key, val := splitted[0], splitted[1]
 
-		fmt.Fprintf(w, "%s%s: %s\n", indent, color.Apply(key, getColorByKeyIndent(0, 2, op.DarkBackground)), color.Apply(val, getColorByValueType(val, op.DarkBackground)))
+		fmt.Fprintf(w, "%s%s: %s\n", indent, color.Apply(key, getColorByKeyIndent(0, 2, op.Theme)), color.Apply(val, getColorByValueType(val, op.Theme)))
 	}
 }
 
-func (op *OptionsPrinter) firstLineColor() color.Color {
-	if op.DarkBackground {
-		return StringColorForDark
-	}
-
-	return StringColorForLight
+func (op *OptionsPrinter) firstLineColor() color.Style {
+	return op.Theme.String
 }
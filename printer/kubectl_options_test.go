@@ -56,7 +56,7 @@ func Test_OptionsPrinter_Print(t *testing.T) {
 			t.Parallel()
 			r := strings.NewReader(tt.input)
 			var w bytes.Buffer
-			printer := OptionsPrinter{DarkBackground: tt.darkBackground}
+			printer := OptionsPrinter{Theme: ThemeForBackground(tt.darkBackground)}
 			printer.Print(r, &w)
 			testutil.MustEqual(t, tt.expected, w.String())
 		})
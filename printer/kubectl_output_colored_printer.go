@@ -2,8 +2,6 @@ package printer
 
 import (
 	"io"
-	"strconv"
-	"strings"
 
 	"github.com/hidetatz/kubecolor/color"
 	"github.com/hidetatz/kubecolor/kubectl"
@@ -13,101 +11,174 @@ import (
 // which kubectl subcommand is executed.
 type KubectlOutputColoredPrinter struct {
 	SubcommandInfo *kubectl.CLICommandInfo
-	DarkBackground bool
+	Theme          *Theme
 	Recursive      bool
+	LogFormat      LogFormat
+	Statuses       StatusRegistry
+	Thresholds     *Thresholds
+	TopBars        bool
+	StableColors   bool
 }
 
 // Print reads r then write it to w, its format is based on kubectl subcommand.
-// If given subcommand is not supported by the printer, it prints data in Green.
+// If given subcommand is not supported by the printer, it prints data in the default color of the theme.
 func (kp *KubectlOutputColoredPrinter) Print(r io.Reader, w io.Writer) {
 	withHeader := !kp.SubcommandInfo.NoHeader
 
-	var printer Printer = &SingleColoredPrinter{Color: color.Green} // default in green
+	var printer Printer = &SingleColoredPrinter{Color: kp.Theme.Default}
 
 	switch kp.SubcommandInfo.Subcommand {
-	case kubectl.Top, kubectl.APIResources:
-		printer = NewTablePrinter(withHeader, kp.DarkBackground, nil)
+	case kubectl.Top:
+		printer = &TopPrinter{WithHeader: withHeader, Theme: kp.Theme, Thresholds: kp.Thresholds, Bars: kp.TopBars}
+	case kubectl.APIResources:
+		printer = kp.newTablePrinter(withHeader, nil)
 
 	case kubectl.APIVersions:
-		printer = NewTablePrinter(false, kp.DarkBackground, nil) // api-versions always doesn't have header
+		printer = kp.newTablePrinter(false, nil) // api-versions always doesn't have header
 
 	case kubectl.Get:
 		switch {
 		case kp.SubcommandInfo.FormatOption == kubectl.None, kp.SubcommandInfo.FormatOption == kubectl.Wide:
-			printer = NewTablePrinter(
+			// the columns are colored by their roles told by the header,
+			// and the function guesses them for the tables without header e.g. --no-headers
+			statusStyle := statusDeciderFn(kp.Theme, kp.Statuses)
+			tp := kp.newTablePrinter(
 				withHeader,
-				kp.DarkBackground,
-				func(_ int, column string) (color.Color, bool) {
-					if column == "CrashLoopBackOff" {
-						return color.Red, true
+				func(index int, column string) (color.Style, bool) {
+					if c, ok := statusStyle(index, column); ok {
+						return c, true
 					}
 
-					// When Readiness is "n/m" then yellow
-					if strings.Count(column, "/") == 1 {
-						if arr := strings.Split(column, "/"); arr[0] != arr[1] {
-							_, e1 := strconv.Atoi(arr[0])
-							_, e2 := strconv.Atoi(arr[1])
-							if e1 == nil && e2 == nil { // check both is number
-								return color.Yellow, true
-							}
-						}
-
-					}
-
-					return 0, false
+					// Readiness "n/m"
+					return readyStyle(column, kp.Theme)
 				},
 			)
-		case kp.SubcommandInfo.FormatOption == kubectl.Json:
-			printer = &JsonPrinter{DarkBackground: kp.DarkBackground}
-		case kp.SubcommandInfo.FormatOption == kubectl.Yaml:
-			printer = &YamlPrinter{DarkBackground: kp.DarkBackground}
+			tp.LabelColumns = kp.SubcommandInfo.LabelColumns
+			printer = tp
 		}
 
 	case kubectl.Describe:
-		printer = &DescribePrinter{
-			DarkBackground: kp.DarkBackground,
-			TablePrinter:   NewTablePrinter(false, kp.DarkBackground, nil),
+		dp := &DescribePrinter{
+			Theme:        kp.Theme,
+			IsRoute:      isRouteResource(kp.SubcommandInfo.Positionals()),
+			Statuses:     kp.Statuses,
+			StableColors: kp.StableColors,
 		}
+		// only the status columns of the tables are colored by the statuses
+		dp.TablePrinter = kp.newTablePrinter(false, dp.tableColor)
+		printer = dp
+	case kubectl.Diff:
+		printer = &DiffPrinter{Theme: kp.Theme}
+	case kubectl.Logs:
+		printer = &LogsPrinter{Theme: kp.Theme, Format: kp.LogFormat}
 	case kubectl.Explain:
 		printer = &ExplainPrinter{
-			DarkBackground: kp.DarkBackground,
-			Recursive:      kp.Recursive,
+			Theme:     kp.Theme,
+			Recursive: kp.Recursive,
 		}
 	case kubectl.Version:
 		switch {
-		case kp.SubcommandInfo.FormatOption == kubectl.Json:
-			printer = &JsonPrinter{DarkBackground: kp.DarkBackground}
-		case kp.SubcommandInfo.FormatOption == kubectl.Yaml:
-			printer = &YamlPrinter{DarkBackground: kp.DarkBackground}
 		case kp.SubcommandInfo.Short:
 			printer = &VersionShortPrinter{
-				DarkBackground: kp.DarkBackground,
+				Theme: kp.Theme,
 			}
 		default:
 			printer = &VersionPrinter{
-				DarkBackground: kp.DarkBackground,
+				Theme: kp.Theme,
 			}
 		}
 	case kubectl.Options:
 		printer = &OptionsPrinter{
-			DarkBackground: kp.DarkBackground,
+			Theme: kp.Theme,
 		}
 	case kubectl.Apply:
-		switch {
-		case kp.SubcommandInfo.FormatOption == kubectl.Json:
-			printer = &JsonPrinter{DarkBackground: kp.DarkBackground}
-		case kp.SubcommandInfo.FormatOption == kubectl.Yaml:
-			printer = &YamlPrinter{DarkBackground: kp.DarkBackground}
+		switch kp.SubcommandInfo.CommandPath() {
+		case "apply view-last-applied":
+			// view-last-applied shows yaml by default
+			printer = &YamlPrinter{Theme: kp.Theme, Statuses: kp.Statuses}
 		default:
-			printer = &ApplyPrinter{DarkBackground: kp.DarkBackground}
+			printer = &ApplyPrinter{Theme: kp.Theme}
 		}
 	case kubectl.Status: // oc status
-		printer = &OpenShiftStatusPrinter{DarkBackground: kp.DarkBackground}
+		printer = &OpenShiftStatusPrinter{Theme: kp.Theme, Statuses: kp.Statuses}
+
+	case kubectl.Rollout:
+		switch kp.SubcommandInfo.CommandPath() {
+		case "rollout status":
+			printer = &RolloutStatusPrinter{Theme: kp.Theme}
+		case "rollout history":
+			printer = &RolloutHistoryPrinter{Theme: kp.Theme}
+		}
+
+	case kubectl.Config:
+		switch kp.SubcommandInfo.CommandPath() {
+		case "config get-contexts":
+			printer = &ConfigGetContextsPrinter{WithHeader: withHeader, Theme: kp.Theme}
+		case "config get-clusters", "config get-users":
+			printer = kp.newTablePrinter(true, nil)
+		case "config view":
+			// config view shows yaml by default
+			printer = &YamlPrinter{Theme: kp.Theme, Statuses: kp.Statuses}
+		}
+
+	case kubectl.Auth:
+		switch kp.SubcommandInfo.CommandPath() {
+		case "auth can-i":
+			printer = &AuthCanIPrinter{WithHeader: withHeader, Theme: kp.Theme}
+		case "auth whoami":
+			printer = kp.newTablePrinter(true, nil)
+		}
+
+	case kubectl.Certificate:
+		printer = &ResourceActionPrinter{
+			Theme: kp.Theme,
+			Actions: map[string]color.Style{
+				"approved": kp.Theme.Success,
+				"denied":   kp.Theme.Error,
+			},
+		}
+
+	case kubectl.Set:
+		if kp.SubcommandInfo.CommandPath() == "set image" {
+			printer = &ResourceActionPrinter{
+				Theme:   kp.Theme,
+				Actions: map[string]color.Style{"image updated": kp.Theme.Apply.Configured},
+			}
+		}
+
+	case kubectl.Kustomize:
+		printer = &YamlPrinter{Theme: kp.Theme, Statuses: kp.Statuses}
+	}
+
+	// these output formats look the same whichever subcommand prints the resources
+	switch kp.SubcommandInfo.FormatOption {
+	case kubectl.Json:
+		printer = &JsonPrinter{Theme: kp.Theme}
+	case kubectl.Yaml:
+		printer = &YamlPrinter{Theme: kp.Theme, Statuses: kp.Statuses}
+	case kubectl.Name:
+		printer = &NamePrinter{Theme: kp.Theme}
+	case kubectl.CustomColumns, kubectl.CustomColumnsFile:
+		printer = kp.newTablePrinter(withHeader, nil)
+	case kubectl.JsonPathAsJson:
+		printer = &JsonPrinter{Theme: kp.Theme}
+	case kubectl.JsonPath, kubectl.GoTemplate, kubectl.TemplateFile:
+		// the format is defined by the user, so kubecolor can only tell they are the values of the resources
+		printer = &SingleColoredPrinter{Color: kp.Theme.String}
 	}
 
 	if kp.SubcommandInfo.Help {
-		printer = &SingleColoredPrinter{Color: color.Yellow}
+		printer = &SingleColoredPrinter{Color: kp.Theme.Help}
 	}
 
 	printer.Print(r, w)
 }
+
+// newTablePrinter returns the TablePrinter which knows the statuses kp has.
+func (kp *KubectlOutputColoredPrinter) newTablePrinter(withHeader bool, colorDeciderFn func(index int, column string) (color.Style, bool)) *TablePrinter {
+	tp := NewTablePrinter(withHeader, kp.Theme, colorDeciderFn)
+	tp.Statuses = kp.Statuses
+	tp.Thresholds = kp.Thresholds
+	tp.StableColors = kp.StableColors
+	return tp
+}
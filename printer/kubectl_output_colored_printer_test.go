@@ -5,6 +5,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hidetatz/kubecolor/color"
 	"github.com/hidetatz/kubecolor/kubectl"
 	"github.com/hidetatz/kubecolor/testutil"
 )
@@ -78,21 +79,21 @@ func Test_KubectlOutputColoredPrinter_Print(t *testing.T) {
 			`),
 			expected: testutil.NewHereDoc(`
 				[37mNAME                              SHORTNAMES   APIGROUP                       NAMESPACED   KIND[0m
-				[36mbindings[0m                                                                      [32mtrue[0m         [35mBinding[0m
-				[36mcomponentstatuses[0m                 [37mcs[0m                                          [32mfalse[0m        [35mComponentStatus[0m
-				[36mpods[0m                              [37mpo[0m                                          [32mtrue[0m         [35mPod[0m
-				[36mpodtemplates[0m                                                                  [32mtrue[0m         [35mPodTemplate[0m
-				[36mreplicationcontrollers[0m            [37mrc[0m                                          [32mtrue[0m         [35mReplicationController[0m
-				[36mresourcequotas[0m                    [37mquota[0m                                       [32mtrue[0m         [35mResourceQuota[0m
-				[36msecrets[0m                                                                       [32mtrue[0m         [35mSecret[0m
-				[36mserviceaccounts[0m                   [37msa[0m                                          [32mtrue[0m         [35mServiceAccount[0m
-				[36mservices[0m                          [37msvc[0m                                         [32mtrue[0m         [35mService[0m
-				[36mmutatingwebhookconfigurations[0m                  [33madmissionregistration.k8s.io[0m   [32mfalse[0m        [35mMutatingWebhookConfiguration[0m
-				[36mcustomresourcedefinitions[0m         [37mcrd,crds[0m     [33mapiextensions.k8s.io[0m           [32mfalse[0m        [35mCustomResourceDefinition[0m
-				[36mcontrollerrevisions[0m                            [33mapps[0m                           [32mtrue[0m         [35mControllerRevision[0m
-				[36mdaemonsets[0m                        [37mds[0m           [33mapps[0m                           [32mtrue[0m         [35mDaemonSet[0m
-				[36mstatefulsets[0m                      [37msts[0m          [33mapps[0m                           [32mtrue[0m         [35mStatefulSet[0m
-				[36mtokenreviews[0m                                   [33mauthentication.k8s.io[0m          [32mfalse[0m        [35mTokenReview[0m
+				[36mbindings[0m                                                                      [37mtrue[0m         [33mBinding[0m
+				[36mcomponentstatuses[0m                 [32mcs[0m                                          [37mfalse[0m        [33mComponentStatus[0m
+				[36mpods[0m                              [32mpo[0m                                          [37mtrue[0m         [33mPod[0m
+				[36mpodtemplates[0m                                                                  [37mtrue[0m         [33mPodTemplate[0m
+				[36mreplicationcontrollers[0m            [32mrc[0m                                          [37mtrue[0m         [33mReplicationController[0m
+				[36mresourcequotas[0m                    [32mquota[0m                                       [37mtrue[0m         [33mResourceQuota[0m
+				[36msecrets[0m                                                                       [37mtrue[0m         [33mSecret[0m
+				[36mserviceaccounts[0m                   [32msa[0m                                          [37mtrue[0m         [33mServiceAccount[0m
+				[36mservices[0m                          [32msvc[0m                                         [37mtrue[0m         [33mService[0m
+				[36mmutatingwebhookconfigurations[0m                  [35madmissionregistration.k8s.io[0m   [37mfalse[0m        [33mMutatingWebhookConfiguration[0m
+				[36mcustomresourcedefinitions[0m         [32mcrd,crds[0m     [35mapiextensions.k8s.io[0m           [37mfalse[0m        [33mCustomResourceDefinition[0m
+				[36mcontrollerrevisions[0m                            [35mapps[0m                           [37mtrue[0m         [33mControllerRevision[0m
+				[36mdaemonsets[0m                        [32mds[0m           [35mapps[0m                           [37mtrue[0m         [33mDaemonSet[0m
+				[36mstatefulsets[0m                      [32msts[0m          [35mapps[0m                           [37mtrue[0m         [33mStatefulSet[0m
+				[36mtokenreviews[0m                                   [35mauthentication.k8s.io[0m          [37mfalse[0m        [33mTokenReview[0m
 			`),
 		},
 		{
@@ -150,9 +151,9 @@ func Test_KubectlOutputColoredPrinter_Print(t *testing.T) {
 				nginx-qdf9b   1/1     Running   0          6d6h`),
 			expected: testutil.NewHereDoc(`
 				[37mNAME          READY   STATUS    RESTARTS   AGE[0m
-				[36mnginx-dnmv5[0m   [32m1/1[0m     [35mRunning[0m   [37m0[0m          [33m6d6h[0m
-				[36mnginx-m8pbc[0m   [32m1/1[0m     [35mRunning[0m   [37m0[0m          [33m6d6h[0m
-				[36mnginx-qdf9b[0m   [32m1/1[0m     [35mRunning[0m   [37m0[0m          [33m6d6h[0m
+				[36mnginx-dnmv5[0m   [32m1/1[0m     [32mRunning[0m   [37m0[0m          [33m6d6h[0m
+				[36mnginx-m8pbc[0m   [32m1/1[0m     [32mRunning[0m   [37m0[0m          [33m6d6h[0m
+				[36mnginx-qdf9b[0m   [32m1/1[0m     [32mRunning[0m   [37m0[0m          [33m6d6h[0m
 			`),
 		},
 		{
@@ -169,8 +170,8 @@ func Test_KubectlOutputColoredPrinter_Print(t *testing.T) {
 			expected: testutil.NewHereDoc(`
 				[37mNAME          READY   STATUS             RESTARTS   AGE[0m
 				[36mnginx-dnmv5[0m   [32m1/1[0m     [31mCrashLoopBackOff[0m   [37m0[0m          [33m6d6h[0m
-				[36mnginx-m8pbc[0m   [32m1/1[0m     [35mRunning[0m            [37m0[0m          [33m6d6h[0m
-				[36mnginx-qdf9b[0m   [33m0/1[0m     [35mRunning[0m            [37m0[0m          [33m6d6h[0m
+				[36mnginx-m8pbc[0m   [32m1/1[0m     [32mRunning[0m            [37m0[0m          [33m6d6h[0m
+				[36mnginx-qdf9b[0m   [31m0/1[0m     [32mRunning[0m            [37m0[0m          [33m6d6h[0m
 			`),
 		},
 		{
@@ -185,9 +186,9 @@ func Test_KubectlOutputColoredPrinter_Print(t *testing.T) {
 				nginx-m8pbc   1/1     Running   0          6d6h
 				nginx-qdf9b   1/1     Running   0          6d6h`),
 			expected: testutil.NewHereDoc(`
-				[36mnginx-dnmv5[0m   [32m1/1[0m     [35mRunning[0m   [37m0[0m          [33m6d6h[0m
-				[36mnginx-m8pbc[0m   [32m1/1[0m     [35mRunning[0m   [37m0[0m          [33m6d6h[0m
-				[36mnginx-qdf9b[0m   [32m1/1[0m     [35mRunning[0m   [37m0[0m          [33m6d6h[0m
+				[36mnginx-dnmv5[0m   [32m1/1[0m     [32mRunning[0m   [37m0[0m          [33m6d6h[0m
+				[36mnginx-m8pbc[0m   [32m1/1[0m     [32mRunning[0m   [37m0[0m          [33m6d6h[0m
+				[36mnginx-qdf9b[0m   [32m1/1[0m     [32mRunning[0m   [37m0[0m          [33m6d6h[0m
 			`),
 		},
 		{
@@ -204,9 +205,41 @@ func Test_KubectlOutputColoredPrinter_Print(t *testing.T) {
 				nginx-6799fc88d8-qdf9b   1/1     Running   0          7d10h   172.18.0.3   minikube   <none>           <none>`),
 			expected: testutil.NewHereDoc(`
 				[37mNAME                     READY   STATUS    RESTARTS   AGE     IP           NODE       NOMINATED NODE   READINESS GATES[0m
-				[36mnginx-6799fc88d8-dnmv5[0m   [32m1/1[0m     [35mRunning[0m   [37m0[0m          [33m7d10h[0m   [36m172.18.0.5[0m   [32mminikube[0m   [35m<none>[0m           [37m<none>[0m
-				[36mnginx-6799fc88d8-m8pbc[0m   [32m1/1[0m     [35mRunning[0m   [37m0[0m          [33m7d10h[0m   [36m172.18.0.4[0m   [32mminikube[0m   [35m<none>[0m           [37m<none>[0m
-				[36mnginx-6799fc88d8-qdf9b[0m   [32m1/1[0m     [35mRunning[0m   [37m0[0m          [33m7d10h[0m   [36m172.18.0.3[0m   [32mminikube[0m   [35m<none>[0m           [37m<none>[0m
+				[36mnginx-6799fc88d8-dnmv5[0m   [32m1/1[0m     [32mRunning[0m   [37m0[0m          [33m7d10h[0m   [34m172.18.0.5[0m   [32mminikube[0m   [2m<none>[0m           [2m<none>[0m
+				[36mnginx-6799fc88d8-m8pbc[0m   [32m1/1[0m     [32mRunning[0m   [37m0[0m          [33m7d10h[0m   [34m172.18.0.4[0m   [32mminikube[0m   [2m<none>[0m           [2m<none>[0m
+				[36mnginx-6799fc88d8-qdf9b[0m   [32m1/1[0m     [32mRunning[0m   [37m0[0m          [33m7d10h[0m   [34m172.18.0.3[0m   [32mminikube[0m   [2m<none>[0m           [2m<none>[0m
+			`),
+		},
+		{
+			name:           "kubectl get pod --show-labels -L",
+			darkBackground: true,
+			subcommandInfo: &kubectl.CLICommandInfo{
+				Subcommand:   kubectl.Get,
+				LabelColumns: []string{"app.kubernetes.io/name", "app.kubernetes.io/version"},
+			},
+			input: testutil.NewHereDoc(`
+				NAME                     READY   STATUS    RESTARTS   AGE   NAME    VERSION   LABELS
+				nginx-6799fc88d8-dnmv5   1/1     Running   0          10d   nginx   1.25      app.kubernetes.io/name=nginx,app.kubernetes.io/version=1.25,pod-template-hash=6799fc88d8
+				web-0                    1/1     Running   0          10d           2.0       example.com/team=web`),
+			expected: testutil.NewHereDoc(`
+				[37mNAME                     READY   STATUS    RESTARTS   AGE   NAME    VERSION   LABELS[0m
+				[36mnginx-6799fc88d8-dnmv5[0m   [32m1/1[0m     [32mRunning[0m   [37m0[0m          [33m10d[0m   [36mnginx[0m   [36m1.25[0m      [2mapp.kubernetes.io/[0m[33mname[0m[2m=[0m[36mnginx[0m[2m,[0m[2mapp.kubernetes.io/[0m[33mversion[0m[2m=[0m[36m1.25[0m[2m,[0m[33mpod-template-hash[0m[2m=[0m[36m6799fc88d8[0m
+				[36mweb-0[0m                    [32m1/1[0m     [32mRunning[0m   [37m0[0m          [33m10d[0m           [36m2.0[0m       [33mexample.com/team[0m[2m=[0m[36mweb[0m
+			`),
+		},
+		{
+			name:           "oc describe route",
+			darkBackground: true,
+			subcommandInfo: &kubectl.CLICommandInfo{
+				Subcommand: kubectl.Describe,
+				Args:       []string{"describe", "route", "my-route"},
+			},
+			input: testutil.NewHereDoc(`
+				Name:           my-route
+				Requested Host: www.example.com`),
+			expected: testutil.NewHereDoc(`
+				[33mName[0m:           [32mmy-route[0m
+				[33mRequested Host[0m: [32mwww.example.com[0m
 			`),
 		},
 		{
@@ -283,6 +316,53 @@ func Test_KubectlOutputColoredPrinter_Print(t *testing.T) {
 				[33mAnnotations[0m:  [33m<none>[0m
 			`),
 		},
+		{
+			name:           "kubectl describe node colors the statuses of conditions and events",
+			darkBackground: true,
+			subcommandInfo: &kubectl.CLICommandInfo{
+				Subcommand: kubectl.Describe,
+			},
+			// the condition types e.g. "Ready" are not statuses, and "False" is healthy for "MemoryPressure"
+			input: testutil.NewHereDoc(`
+				Conditions:
+				  Type             Status  Reason                       Message
+				  ----             ------  ------                       -------
+				  MemoryPressure   False   KubeletHasSufficientMemory   kubelet has sufficient memory available
+				  Ready            False   KubeletNotReady              container runtime is down
+				Events:
+				  Type     Reason   Age   From     Message
+				  ----     ------   ----  ----     -------
+				  Warning  BackOff  10s   kubelet  Back-off restarting failed container`),
+			expected: testutil.NewHereDoc(`
+				[33mConditions[0m:
+				[36m[0m  [32mType[0m             [35mStatus[0m  [37mReason[0m                       [33mMessage[0m
+				[36m[0m  [32m----[0m             [35m------[0m  [37m------[0m                       [33m-------[0m
+				[36m[0m  [32mMemoryPressure[0m   [32mFalse[0m   [37mKubeletHasSufficientMemory[0m   [33mkubelet has sufficient memory available[0m
+				[36m[0m  [32mReady[0m            [31mFalse[0m   [37mKubeletNotReady[0m              [33mcontainer runtime is down[0m
+				[33mEvents[0m:
+				[36m[0m  [32mType[0m     [36mReason[0m   [32mAge[0m   [35mFrom[0m     [37mMessage[0m
+				[36m[0m  [32m----[0m     [36m------[0m   [32m----[0m  [35m----[0m     [37m-------[0m
+				[36m[0m  [33mWarning[0m  [31mBackOff[0m  [32m10s[0m   [35mkubelet[0m  [37mBack-off restarting failed container[0m
+			`),
+		},
+		{
+			name:           "kubectl describe pod colors the statuses of conditions",
+			darkBackground: true,
+			subcommandInfo: &kubectl.CLICommandInfo{
+				Subcommand: kubectl.Describe,
+			},
+			input: testutil.NewHereDoc(`
+				Conditions:
+				  Type              Status
+				  Initialized       True
+				  Ready             False`),
+			expected: testutil.NewHereDoc(`
+				[33mConditions[0m:
+				  [37mType[0m              [36mStatus[0m
+				  [37mInitialized[0m       [32mTrue[0m
+				  [37mReady[0m             [31mFalse[0m
+			`),
+		},
 		{
 			name:           "kubectl api-versions",
 			darkBackground: true,
@@ -542,13 +622,13 @@ func Test_KubectlOutputColoredPrinter_Print(t *testing.T) {
 				    [33mlastUpdateTime[0m: "[36m2020-11-04T13:14:27Z[0m"
 				    [33mmessage[0m: [36mReplicaSet "nginx-f89759699" has successfully progressed.[0m
 				    [33mreason[0m: [36mNewReplicaSetAvailable[0m
-				    [33mstatus[0m: "[36mTrue[0m"
+				    [33mstatus[0m: "[32mTrue[0m"
 				    [33mtype[0m: [36mProgressing[0m
 				  - [33mlastTransitionTime[0m: "[36m2020-12-27T04:41:49Z[0m"
 				    [33mlastUpdateTime[0m: "[36m2020-12-27T04:41:49Z[0m"
 				    [33mmessage[0m: [36mDeployment has minimum availability.[0m
 				    [33mreason[0m: [36mMinimumReplicasAvailable[0m
-				    [33mstatus[0m: "[36mTrue[0m"
+				    [33mstatus[0m: "[32mTrue[0m"
 				    [33mtype[0m: [36mAvailable[0m
 				  [37mobservedGeneration[0m: [35m3[0m
 				  [37mreadyReplicas[0m: [35m3[0m
@@ -556,6 +636,279 @@ func Test_KubectlOutputColoredPrinter_Print(t *testing.T) {
 				  [37mupdatedReplicas[0m: [35m3[0m
 			`),
 		},
+		{
+			name:           "kubectl rollout status",
+			darkBackground: true,
+			subcommandInfo: &kubectl.CLICommandInfo{
+				Subcommand:     kubectl.Rollout,
+				SubcommandPath: []string{"status"},
+			},
+			input: testutil.NewHereDoc(`
+				Waiting for deployment "nginx" rollout to finish: 2 of 3 updated replicas are available...
+				deployment "nginx" successfully rolled out`),
+			expected: testutil.NewHereDoc(`
+				[33mWaiting for deployment "nginx" rollout to finish: 2 of 3 updated replicas are available...[0m
+				[32mdeployment "nginx" successfully rolled out[0m
+			`),
+		},
+		{
+			name:           "kubectl config get-contexts",
+			darkBackground: true,
+			subcommandInfo: &kubectl.CLICommandInfo{
+				Subcommand:     kubectl.Config,
+				SubcommandPath: []string{"get-contexts"},
+			},
+			input: testutil.NewHereDoc(`
+				CURRENT   NAME        CLUSTER     AUTHINFO    NAMESPACE
+				*         kind-kind   kind-kind   kind-kind   
+				          prod        prod        prod        default`),
+			expected: testutil.NewHereDoc(`
+				[37mCURRENT   NAME        CLUSTER     AUTHINFO    NAMESPACE[0m
+				[32m*         kind-kind   kind-kind   kind-kind   [0m
+				          [32mprod[0m        [35mprod[0m        [37mprod[0m        [33mdefault[0m
+			`),
+		},
+		{
+			name:           "kubectl certificate approve",
+			darkBackground: true,
+			subcommandInfo: &kubectl.CLICommandInfo{
+				Subcommand:     kubectl.Certificate,
+				SubcommandPath: []string{"approve"},
+			},
+			input: testutil.NewHereDoc(`
+				certificatesigningrequest.certificates.k8s.io/csr-8b2pj approved`),
+			expected: testutil.NewHereDoc(`
+				certificatesigningrequest.certificates.k8s.io/csr-8b2pj [32mapproved[0m
+			`),
+		},
+		{
+			name:           "kubectl get pod -o name",
+			darkBackground: true,
+			subcommandInfo: &kubectl.CLICommandInfo{
+				Subcommand:   kubectl.Get,
+				FormatOption: kubectl.Name,
+			},
+			input: testutil.NewHereDoc(`
+				pod/nginx-dnmv5
+				pod/nginx-m8pbc`),
+			expected: testutil.NewHereDoc(`
+				[36mpod[0m/[32mnginx-dnmv5[0m
+				[36mpod[0m/[32mnginx-m8pbc[0m
+			`),
+		},
+		{
+			name:           "kubectl get pod -o custom-columns",
+			darkBackground: true,
+			subcommandInfo: &kubectl.CLICommandInfo{
+				Subcommand:   kubectl.Get,
+				FormatOption: kubectl.CustomColumns,
+			},
+			input: testutil.NewHereDoc(`
+				NAME          NODE
+				nginx-dnmv5   kind-worker
+				nginx-m8pbc   kind-worker2`),
+			expected: testutil.NewHereDoc(`
+				[37mNAME          NODE[0m
+				[36mnginx-dnmv5[0m   [32mkind-worker[0m
+				[36mnginx-m8pbc[0m   [32mkind-worker2[0m
+			`),
+		},
+		{
+			name:           "kubectl get pod -o custom-columns --no-headers",
+			darkBackground: true,
+			subcommandInfo: &kubectl.CLICommandInfo{
+				Subcommand:   kubectl.Get,
+				FormatOption: kubectl.CustomColumns,
+				NoHeader:     true,
+			},
+			input: testutil.NewHereDoc(`
+				nginx-dnmv5   kind-worker
+				nginx-m8pbc   kind-worker2`),
+			expected: testutil.NewHereDoc(`
+				[36mnginx-dnmv5[0m   [32mkind-worker[0m
+				[36mnginx-m8pbc[0m   [32mkind-worker2[0m
+			`),
+		},
+		{
+			name:           "kubectl get pod -o jsonpath-as-json",
+			darkBackground: true,
+			subcommandInfo: &kubectl.CLICommandInfo{
+				Subcommand:   kubectl.Get,
+				FormatOption: kubectl.JsonPathAsJson,
+			},
+			input: testutil.NewHereDoc(`
+				[
+				    "nginx-dnmv5",
+				    "nginx-m8pbc"
+				]`),
+			expected: testutil.NewHereDoc(`
+				[
+				    "[36mnginx-dnmv5[0m",
+				    "[36mnginx-m8pbc[0m"
+				]
+			`),
+		},
+		{
+			name:           "kubectl get pod -o jsonpath",
+			darkBackground: true,
+			subcommandInfo: &kubectl.CLICommandInfo{
+				Subcommand:   kubectl.Get,
+				FormatOption: kubectl.JsonPath,
+			},
+			input: testutil.NewHereDoc(`
+				nginx-dnmv5 nginx-m8pbc`),
+			expected: testutil.NewHereDoc(`
+				[36mnginx-dnmv5 nginx-m8pbc[0m
+			`),
+		},
+		{
+			name:           "kubectl apply -o name",
+			darkBackground: true,
+			subcommandInfo: &kubectl.CLICommandInfo{
+				Subcommand:   kubectl.Apply,
+				FormatOption: kubectl.Name,
+			},
+			input: testutil.NewHereDoc(`
+				deployment.apps/nginx`),
+			expected: testutil.NewHereDoc(`
+				[36mdeployment.apps[0m/[32mnginx[0m
+			`),
+		},
+		{
+			name:           "kubectl apply view-last-applied",
+			darkBackground: true,
+			subcommandInfo: &kubectl.CLICommandInfo{
+				Subcommand:     kubectl.Apply,
+				SubcommandPath: []string{"view-last-applied"},
+			},
+			input: testutil.NewHereDoc(`
+				apiVersion: apps/v1
+				kind: Deployment
+				metadata:
+				  name: nginx
+				spec:
+				  replicas: 3`),
+			expected: testutil.NewHereDoc(`
+				[33mapiVersion[0m: [36mapps/v1[0m
+				[33mkind[0m: [36mDeployment[0m
+				[33mmetadata[0m:
+				  [37mname[0m: [36mnginx[0m
+				[33mspec[0m:
+				  [37mreplicas[0m: [35m3[0m
+			`),
+		},
+		{
+			name:           "kubectl apply view-last-applied -o json",
+			darkBackground: true,
+			subcommandInfo: &kubectl.CLICommandInfo{
+				Subcommand:     kubectl.Apply,
+				SubcommandPath: []string{"view-last-applied"},
+				FormatOption:   kubectl.Json,
+			},
+			input: testutil.NewHereDoc(`
+				{
+				    "kind": "Deployment",
+				    "spec": {
+				        "replicas": 3
+				    }
+				}`),
+			expected: testutil.NewHereDoc(`
+				{
+				    "[37mkind[0m": "[36mDeployment[0m",
+				    "[37mspec[0m": {
+				        "[33mreplicas[0m": [35m3[0m
+				    }
+				}
+			`),
+		},
+		{
+			name:           "kubectl create deployment --dry-run=client -o yaml",
+			darkBackground: true,
+			subcommandInfo: &kubectl.CLICommandInfo{
+				Subcommand:     kubectl.Create,
+				SubcommandPath: []string{"deployment"},
+				FormatOption:   kubectl.Yaml,
+				DryRun:         true,
+			},
+			input: testutil.NewHereDoc(`
+				apiVersion: apps/v1
+				kind: Deployment
+				metadata:
+				  name: nginx
+				spec:
+				  replicas: 1`),
+			expected: testutil.NewHereDoc(`
+				[33mapiVersion[0m: [36mapps/v1[0m
+				[33mkind[0m: [36mDeployment[0m
+				[33mmetadata[0m:
+				  [37mname[0m: [36mnginx[0m
+				[33mspec[0m:
+				  [37mreplicas[0m: [35m1[0m
+			`),
+		},
+		{
+			name:           "kubectl expose -o json",
+			darkBackground: true,
+			subcommandInfo: &kubectl.CLICommandInfo{
+				Subcommand:   kubectl.Expose,
+				FormatOption: kubectl.Json,
+			},
+			input: testutil.NewHereDoc(`
+				{
+				    "apiVersion": "v1",
+				    "kind": "Service"
+				}`),
+			expected: testutil.NewHereDoc(`
+				{
+				    "[37mapiVersion[0m": "[36mv1[0m",
+				    "[37mkind[0m": "[36mService[0m"
+				}
+			`),
+		},
+		{
+			name:           "kubectl kustomize",
+			darkBackground: true,
+			subcommandInfo: &kubectl.CLICommandInfo{
+				Subcommand: kubectl.Kustomize,
+			},
+			input: testutil.NewHereDoc(`
+				apiVersion: v1
+				kind: ConfigMap`),
+			expected: testutil.NewHereDoc(`
+				[33mapiVersion[0m: [36mv1[0m
+				[33mkind[0m: [36mConfigMap[0m
+			`),
+		},
+		{
+			name:           "kubectl logs",
+			darkBackground: true,
+			subcommandInfo: &kubectl.CLICommandInfo{
+				Subcommand: kubectl.Logs,
+			},
+			input: testutil.NewHereDoc(`
+				2023/01/02 15:04:05 [INFO] server started
+				2023/01/02 15:04:07 [ERROR] request failed`),
+			expected: testutil.NewHereDoc(`
+				2023/01/02 15:04:05 [[32mINFO[0m] server started
+				2023/01/02 15:04:07 [[31mERROR[0m] request failed
+			`),
+		},
+		{
+			name:           "kubectl diff",
+			darkBackground: true,
+			subcommandInfo: &kubectl.CLICommandInfo{
+				Subcommand: kubectl.Diff,
+			},
+			input: testutil.NewHereDoc(`
+				@@ -1 +1 @@
+				-  replicas: 1
+				+  replicas: 3`),
+			expected: testutil.NewHereDoc(`
+				[36m@@ -1 +1 @@[0m
+				[31m-  replicas: [0m[7;31m1[0m
+				[32m+  replicas: [0m[7;32m3[0m
+			`),
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -565,10 +918,72 @@ func Test_KubectlOutputColoredPrinter_Print(t *testing.T) {
 			var w bytes.Buffer
 			printer := KubectlOutputColoredPrinter{
 				SubcommandInfo: tt.subcommandInfo,
-				DarkBackground: tt.darkBackground,
+				Theme:          ThemeForBackground(tt.darkBackground),
 			}
 			printer.Print(r, &w)
 			testutil.MustEqual(t, tt.expected, w.String())
 		})
 	}
 }
+
+func Test_KubectlOutputColoredPrinter_Print_StableColors(t *testing.T) {
+	theme := ThemeForBackground(true)
+	namespace := color.Apply("kube-system", stableColor("kube-system", theme.Table))
+	node := color.Apply("minikube", stableColor("minikube", theme.Table))
+	pod := func(name string) string { return color.Apply(name, stableColor("coredns", theme.Table)) }
+	// the containers in logs --prefix keep their own colors to be told apart
+	prefix := func(s string) string { return color.Apply(s, stableColor(s, theme.Table)) }
+
+	tests := []struct {
+		name           string
+		subcommandInfo *kubectl.CLICommandInfo
+		input          string
+		expected       []string // the values must be colored in the same way in every command
+	}{
+		{
+			name:           "kubectl get pod -o wide",
+			subcommandInfo: &kubectl.CLICommandInfo{Subcommand: kubectl.Get, FormatOption: kubectl.Wide},
+			input: testutil.NewHereDoc(`
+				NAMESPACE     NAME                       READY   STATUS    RESTARTS   AGE   IP           NODE       NOMINATED NODE   READINESS GATES
+				kube-system   coredns-5d78c9869d-kbwcl   1/1     Running   0          10d   10.244.0.2   minikube   <none>           <none>`),
+			expected: []string{namespace, pod("coredns-5d78c9869d-kbwcl"), node},
+		},
+		{
+			name:           "kubectl describe pod",
+			subcommandInfo: &kubectl.CLICommandInfo{Subcommand: kubectl.Describe},
+			input: testutil.NewHereDoc(`
+				Name:             coredns-5d78c9869d-kbwcl
+				Namespace:        kube-system
+				Node:             minikube/192.168.49.2
+				Controlled By:    ReplicaSet/coredns-5d78c9869d`),
+			expected: []string{namespace, node, pod("coredns-5d78c9869d")},
+		},
+		{
+			name:           "kubectl logs --prefix",
+			subcommandInfo: &kubectl.CLICommandInfo{Subcommand: kubectl.Logs},
+			input: testutil.NewHereDoc(`
+				[pod/coredns-5d78c9869d-kbwcl/coredns] [INFO] plugin/reload: Running configuration SHA512 = 591cf328cccc12bc490481273e738df59329c62c0b729d94e8b61db9961c2fa5f046dd37f1cf888b953814040d180f52594972691cd6ff41be96639138a43908
+				[pod/coredns-5d78c9869d-xk2vq/coredns] [INFO] 10.244.0.1:51234 - 12345 "A IN example.com. udp 29 false 512" NOERROR qr,rd,ra 56 0.0123s`),
+			expected: []string{prefix("[pod/coredns-5d78c9869d-kbwcl/coredns]"), prefix("[pod/coredns-5d78c9869d-xk2vq/coredns]")},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := strings.NewReader(tt.input)
+			var w bytes.Buffer
+			printer := KubectlOutputColoredPrinter{
+				SubcommandInfo: tt.subcommandInfo,
+				Theme:          theme,
+				StableColors:   true,
+			}
+			printer.Print(r, &w)
+			for _, expected := range tt.expected {
+				if !strings.Contains(w.String(), expected) {
+					t.Errorf("%q is not found in %q", expected, w.String())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package printer
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/hidetatz/kubecolor/color"
+)
+
+// RolloutStatusPrinter is a specific printer for kubectl rollout status.
+// e.g.
+//
+//	Waiting for deployment "nginx" rollout to finish: 1 of 3 updated replicas are available...
+//	deployment "nginx" successfully rolled out
+type RolloutStatusPrinter struct {
+	Theme *Theme
+}
+
+func (rp *RolloutStatusPrinter) Print(r io.Reader, w io.Writer) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		c := rp.Theme.Default
+		switch {
+		case strings.Contains(line, "successfully rolled out"),
+			strings.Contains(line, "roll out complete"),       // statefulset with partition
+			strings.Contains(line, "rolling update complete"): // statefulset
+			c = rp.Theme.Success
+		case strings.HasPrefix(line, "Waiting for"):
+			c = rp.Theme.Warning
+		}
+
+		fmt.Fprintf(w, "%s\n", color.Apply(line, c))
+	}
+}
+
+// rolloutHistoryTitle is the first line of kubectl rollout history output,
+// e.g. "deployment.apps/nginx" or "deployment.apps/nginx with revision #2"
+var rolloutHistoryTitle = regexp.MustCompile(`^\S+/\S+( with revision #\d+)?\s*$`)
+
+// RolloutHistoryPrinter is a specific printer for kubectl rollout history.
+// It prints the list of revisions as a table:
+//
+//	deployment.apps/nginx
+//	REVISION  CHANGE-CAUSE
+//	1         <none>
+//	2         kubectl set image deployment/nginx nginx=nginx:1.25
+//
+// and the pod template of a revision (--revision) in describe format:
+//
+//	deployment.apps/nginx with revision #2
+//	Pod Template:
+//	  Labels:       app=nginx
+type RolloutHistoryPrinter struct {
+	Theme *Theme
+}
+
+func (rp *RolloutHistoryPrinter) Print(r io.Reader, w io.Writer) {
+	tp := NewTablePrinter(false, rp.Theme, nil)
+	dp := &DescribePrinter{
+		Theme:        rp.Theme,
+		TablePrinter: NewTablePrinter(false, rp.Theme, nil),
+	}
+
+	describing := false
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.TrimSpace(line) == "":
+			fmt.Fprintln(w, line)
+		case rolloutHistoryTitle.MatchString(line):
+			fmt.Fprintf(w, "%s\n", color.Apply(line, rp.Theme.Section))
+			describing = strings.Contains(line, " with revision #")
+		case describing:
+			dp.printLine(w, line, false)
+		case strings.HasPrefix(line, "REVISION"):
+			fmt.Fprintf(w, "%s\n", color.Apply(line, rp.Theme.Header))
+		default:
+			tp.printLineAsTableFormat(w, line, rp.Theme.Table)
+		}
+	}
+}
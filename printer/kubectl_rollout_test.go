@@ -0,0 +1,109 @@
+package printer
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/hidetatz/kubecolor/testutil"
+)
+
+func Test_RolloutStatusPrinter_Print(t *testing.T) {
+	tests := []struct {
+		name           string
+		darkBackground bool
+		input          string
+		expected       string
+	}{
+		{
+			name:           "deployment",
+			darkBackground: true,
+			input: testutil.NewHereDoc(`
+				Waiting for deployment "nginx" rollout to finish: 1 of 3 updated replicas are available...
+				deployment "nginx" successfully rolled out`),
+			expected: testutil.NewHereDoc(`
+				[33mWaiting for deployment "nginx" rollout to finish: 1 of 3 updated replicas are available...[0m
+				[32mdeployment "nginx" successfully rolled out[0m
+			`),
+		},
+		{
+			name:           "statefulset",
+			darkBackground: true,
+			input: testutil.NewHereDoc(`
+				Waiting for 1 pods to be ready...
+				partitioned roll out complete: 2 new pods have been updated...`),
+			expected: testutil.NewHereDoc(`
+				[33mWaiting for 1 pods to be ready...[0m
+				[32mpartitioned roll out complete: 2 new pods have been updated...[0m
+			`),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := strings.NewReader(tt.input)
+			var w bytes.Buffer
+			printer := RolloutStatusPrinter{Theme: ThemeForBackground(tt.darkBackground)}
+			printer.Print(r, &w)
+			testutil.MustEqual(t, tt.expected, w.String())
+		})
+	}
+}
+
+func Test_RolloutHistoryPrinter_Print(t *testing.T) {
+	tests := []struct {
+		name           string
+		darkBackground bool
+		input          string
+		expected       string
+	}{
+		{
+			name:           "revisions",
+			darkBackground: true,
+			input: testutil.NewHereDoc(`
+				deployment.apps/nginx
+				REVISION  CHANGE-CAUSE
+				1         <none>
+				2         kubectl set image deployment/nginx nginx=nginx:1.25
+				`),
+			expected: testutil.NewHereDoc(`
+				[33mdeployment.apps/nginx[0m
+				[37mREVISION  CHANGE-CAUSE[0m
+				[36m1[0m         [32m<none>[0m
+				[36m2[0m         [32mkubectl set image deployment/nginx nginx=nginx:1.25[0m
+			`),
+		},
+		{
+			name:           "a revision",
+			darkBackground: true,
+			input: testutil.NewHereDoc(`
+				deployment.apps/nginx with revision #2
+				Pod Template:
+				  Labels:       app=nginx
+				  Containers:
+				   nginx:
+				    Image:      nginx:1.25
+				`),
+			expected: testutil.NewHereDoc(`
+				[33mdeployment.apps/nginx with revision #2[0m
+				[33mPod Template[0m:
+				  [37mLabels[0m:       [36mapp=nginx[0m
+				  [37mContainers[0m:
+				   [37mnginx[0m:
+				    [33mImage[0m:      [36mnginx:1.25[0m
+			`),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := strings.NewReader(tt.input)
+			var w bytes.Buffer
+			printer := RolloutHistoryPrinter{Theme: ThemeForBackground(tt.darkBackground)}
+			printer.Print(r, &w)
+			testutil.MustEqual(t, tt.expected, w.String())
+		})
+	}
+}
@@ -0,0 +1,249 @@
+package printer
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/hidetatz/kubecolor/color"
+)
+
+// topBarWidth is the number of the cells of a bar shown next to a percentage
+const topBarWidth = 10
+
+// parsePercentage parses a percentage e.g. "12%". ok is false when s is not a percentage.
+func parsePercentage(s string) (v float64, ok bool) {
+	if !strings.HasSuffix(s, "%") {
+		return 0, false
+	}
+
+	v, err := strconv.ParseFloat(strings.TrimSuffix(s, "%"), 64)
+	return v, err == nil
+}
+
+// topColumn is a column of kubectl top.
+type topColumn struct {
+	start     int
+	name      string
+	isPercent bool // e.g. "CPU%"
+	isUsage   bool // e.g. "CPU(cores)"
+	width     int  // the width of the widest value in the column, including the header
+}
+
+// TopPrinter is a printer for kubectl top.
+// The usages are colored like a heat map: CPU% and MEMORY% by the thresholds of percentage,
+// and the usages like "250m" by the percentage of the resource in the same row.
+// The usages without percentage e.g. kubectl top pod get the colors of their positions,
+// because a usage alone doesn't tell how busy the resource is.
+// e.g.
+//
+//	NAME       CPU(cores)   CPU%   MEMORY(bytes)   MEMORY%
+//	minikube   250m         12%    1024Mi          26%
+//
+// When Bars is true, a bar showing the percentage is added next to each percentage.
+type TopPrinter struct {
+	WithHeader bool
+	Theme      *Theme
+	Thresholds *Thresholds
+	Bars       bool
+}
+
+func (tp *TopPrinter) Print(r io.Reader, w io.Writer) {
+	// kubectl top writes the whole output at once, so it's fine to read everything first.
+	// It's needed to know the widest value in each column to align the bars.
+	lines := []string{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+
+	table := NewTablePrinter(tp.WithHeader, tp.Theme, nil)
+	if !tp.WithHeader || len(lines) == 0 {
+		table.Print(strings.NewReader(strings.Join(lines, "\n")), w)
+		return
+	}
+
+	columns := tp.parseHeader(lines[0])
+	rows := lines[1:]
+	for _, row := range rows {
+		if !isTopSummary(row) {
+			tp.measure(columns, row)
+		}
+	}
+
+	tp.printRow(w, columns, lines[0], table, true)
+	for _, row := range rows {
+		tp.printRow(w, columns, row, table, false)
+	}
+}
+
+// isTopSummary returns true when the row is a part of the sum shown by --sum,
+// which doesn't have the name of a resource.
+func isTopSummary(row string) bool {
+	return strings.HasPrefix(row, " ")
+}
+
+func (tp *TopPrinter) parseHeader(header string) []topColumn {
+	columns := []topColumn{}
+	headerColumns := parseTableHeader(header)
+	for i, c := range headerColumns {
+		end := len(header)
+		if i+1 < len(headerColumns) {
+			end = headerColumns[i+1].start
+		}
+		name := strings.TrimSpace(header[c.start:end])
+		columns = append(columns, topColumn{
+			start:     c.start,
+			name:      name,
+			isPercent: strings.HasSuffix(name, "%"),
+			isUsage:   strings.HasSuffix(name, ")"), // e.g. "CPU(cores)", "MEMORY(bytes)"
+			width:     len(name),
+		})
+	}
+
+	return columns
+}
+
+// measure updates the widths of columns with row.
+func (tp *TopPrinter) measure(columns []topColumn, row string) {
+	for i := range columns {
+		if value := tp.value(columns, i, row); len(value) > columns[i].width {
+			columns[i].width = len(value)
+		}
+	}
+}
+
+// value returns the value of i-th column in row.
+func (tp *TopPrinter) value(columns []topColumn, i int, row string) string {
+	end := len(row)
+	if i+1 < len(columns) {
+		end = columns[i+1].start
+	}
+
+	return strings.TrimSpace(cell(row, columns[i].start, end))
+}
+
+// cell returns line[start:end], which is cut at the end of line.
+func cell(line string, start, end int) string {
+	if start >= len(line) {
+		return ""
+	}
+	if end > len(line) {
+		end = len(line)
+	}
+
+	return line[start:end]
+}
+
+func (tp *TopPrinter) printRow(w io.Writer, columns []topColumn, row string, table *TablePrinter, isHeader bool) {
+	var b strings.Builder
+	for i, c := range columns {
+		end := len(row)
+		if i+1 < len(columns) {
+			end = columns[i+1].start
+		}
+		region := cell(row, c.start, end)
+		value := strings.TrimSpace(region)
+		// the spaces before the next column, which are kept to preserve the alignment
+		padding := strings.TrimPrefix(region, value)
+
+		switch {
+		case isHeader, value == "":
+			b.WriteString(value)
+		default:
+			b.WriteString(color.Apply(value, tp.styleFor(columns, i, row, table)))
+		}
+
+		if tp.Bars && c.isPercent {
+			// the bar is put after the widest value, so the bars are aligned too
+			b.WriteString(toSpaces(c.width - len(value) + 1))
+			if isHeader {
+				b.WriteString(toSpaces(topBarWidth))
+			} else {
+				b.WriteString(tp.bar(value))
+			}
+			padding = strings.TrimPrefix(padding, toSpaces(c.width-len(value)))
+		}
+
+		b.WriteString(padding)
+	}
+
+	line := strings.TrimRight(b.String(), " ")
+	if isHeader {
+		line = color.Apply(line, tp.Theme.Header)
+	}
+	fmt.Fprintf(w, "%s\n", line)
+}
+
+// styleFor returns the style of the i-th column in row.
+func (tp *TopPrinter) styleFor(columns []topColumn, i int, row string, table *TablePrinter) color.Style {
+	c := columns[i]
+	index := 0
+	if c.start != 0 {
+		index = c.start + 1
+	}
+	style := table.decideColorForTable(index, tp.Theme.Table)
+
+	if isTopSummary(row) {
+		return style
+	}
+
+	value := tp.value(columns, i, row)
+	switch {
+	case c.isPercent:
+		if p, ok := parsePercentage(value); ok {
+			return tp.heatStyle(p)
+		}
+	case c.isUsage:
+		// e.g. "CPU(cores)" is colored as "CPU%" when it's shown
+		resource, _, _ := strings.Cut(c.name, "(")
+		for j, other := range columns {
+			if other.name == resource+"%" {
+				if p, ok := parsePercentage(tp.value(columns, j, row)); ok {
+					return tp.heatStyle(p)
+				}
+			}
+		}
+	}
+
+	return style
+}
+
+// heatStyle returns the style of a usage in percentage.
+func (tp *TopPrinter) heatStyle(percent float64) color.Style {
+	thresholds := tp.Thresholds
+	if thresholds == nil {
+		thresholds = DefaultThresholds()
+	}
+
+	switch {
+	case percent >= float64(thresholds.UsageError):
+		return tp.Theme.Error
+	case percent >= float64(thresholds.UsageWarning):
+		return tp.Theme.Warning
+	}
+
+	return tp.Theme.Success
+}
+
+// bar returns the bar showing the percentage e.g. "███░░░░░░░" for "30%".
+// It's always topBarWidth wide so that the columns stay aligned.
+func (tp *TopPrinter) bar(value string) string {
+	p, ok := parsePercentage(value)
+	if !ok {
+		return toSpaces(topBarWidth)
+	}
+
+	filled := int(math.Round(p / 100 * topBarWidth))
+	if filled < 0 {
+		filled = 0
+	}
+	if filled > topBarWidth {
+		filled = topBarWidth
+	}
+
+	return color.Apply(strings.Repeat("█", filled)+strings.Repeat("░", topBarWidth-filled), tp.heatStyle(p))
+}
@@ -0,0 +1,114 @@
+package printer
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/hidetatz/kubecolor/testutil"
+)
+
+func Test_TopPrinter_Print(t *testing.T) {
+	tests := []struct {
+		name           string
+		darkBackground bool
+		withHeader     bool
+		bars           bool
+		thresholds     *Thresholds
+		input          string
+		expected       string
+	}{
+		{
+			name:           "nodes are colored by their percentages",
+			darkBackground: true,
+			withHeader:     true,
+			input: testutil.NewHereDoc(`
+				NAME       CPU(cores)   CPU%   MEMORY(bytes)   MEMORY%
+				minikube   250m         12%    1024Mi          26%
+				worker-1   3800m        95%    6144Mi          78%`),
+			expected: testutil.NewHereDoc(`
+				[37mNAME       CPU(cores)   CPU%   MEMORY(bytes)   MEMORY%[0m
+				[36mminikube[0m   [32m250m[0m         [32m12%[0m    [32m1024Mi[0m          [32m26%[0m
+				[36mworker-1[0m   [31m3800m[0m        [31m95%[0m    [33m6144Mi[0m          [33m78%[0m
+			`),
+		},
+		{
+			name:           "thresholds can be changed",
+			darkBackground: true,
+			withHeader:     true,
+			thresholds:     &Thresholds{UsageWarning: 10, UsageError: 50},
+			input: testutil.NewHereDoc(`
+				NAME       CPU(cores)   CPU%   MEMORY(bytes)   MEMORY%
+				minikube   250m         12%    1024Mi          26%`),
+			expected: testutil.NewHereDoc(`
+				[37mNAME       CPU(cores)   CPU%   MEMORY(bytes)   MEMORY%[0m
+				[36mminikube[0m   [33m250m[0m         [33m12%[0m    [33m1024Mi[0m          [33m26%[0m
+			`),
+		},
+		{
+			name:           "usages without percentages get the colors of their positions",
+			darkBackground: true,
+			withHeader:     true,
+			// the largest usage is not always busy
+			input: testutil.NewHereDoc(`
+				POD         NAME    CPU(cores)   MEMORY(bytes)
+				app-29twd   app     1m           10Mi
+				app-29twd   proxy   2m           12Mi
+				app-2hhr6   app     1m           11Mi`),
+			expected: testutil.NewHereDoc(`
+				[37mPOD         NAME    CPU(cores)   MEMORY(bytes)[0m
+				[36mapp-29twd[0m   [32mapp[0m     [35m1m[0m           [37m10Mi[0m
+				[36mapp-29twd[0m   [32mproxy[0m   [35m2m[0m           [37m12Mi[0m
+				[36mapp-2hhr6[0m   [32mapp[0m     [35m1m[0m           [37m11Mi[0m
+			`),
+		},
+		{
+			name:           "a single usage gets the color of its position",
+			darkBackground: true,
+			withHeader:     true,
+			input: testutil.NewHereDoc(`
+				NAME        CPU(cores)   MEMORY(bytes)
+				app-29twd   779m         221Mi`),
+			expected: testutil.NewHereDoc(`
+				[37mNAME        CPU(cores)   MEMORY(bytes)[0m
+				[36mapp-29twd[0m   [32m779m[0m         [35m221Mi[0m
+			`),
+		},
+		{
+			name:           "bars keep the alignment",
+			darkBackground: true,
+			withHeader:     true,
+			bars:           true,
+			input: testutil.NewHereDoc(`
+				NAME       CPU(cores)   CPU%   MEMORY(bytes)   MEMORY%
+				minikube   250m         12%    1024Mi          26%
+				worker-1   3800m        100%   6144Mi          78%`),
+			expected: testutil.NewHereDoc(`
+				[37mNAME       CPU(cores)   CPU%              MEMORY(bytes)   MEMORY%[0m
+				[36mminikube[0m   [32m250m[0m         [32m12%[0m  [32m█░░░░░░░░░[0m   [32m1024Mi[0m          [32m26%[0m     [32m███░░░░░░░[0m
+				[36mworker-1[0m   [31m3800m[0m        [31m100%[0m [31m██████████[0m   [33m6144Mi[0m          [33m78%[0m     [33m████████░░[0m
+			`),
+		},
+		{
+			name:           "without header",
+			darkBackground: true,
+			withHeader:     false,
+			input: testutil.NewHereDoc(`
+				minikube   250m   12%   1024Mi   26%`),
+			expected: testutil.NewHereDoc(`
+				[36mminikube[0m   [32m250m[0m   [35m12%[0m   [37m1024Mi[0m   [33m26%[0m
+			`),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := strings.NewReader(tt.input)
+			var w bytes.Buffer
+			printer := TopPrinter{WithHeader: tt.withHeader, Theme: ThemeForBackground(tt.darkBackground), Thresholds: tt.thresholds, Bars: tt.bars}
+			printer.Print(r, &w)
+			testutil.MustEqual(t, tt.expected, w.String())
+		})
+	}
+}
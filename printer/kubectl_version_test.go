@@ -34,7 +34,7 @@ func Test_VersionPrinter_Print(t *testing.T) {
 			t.Parallel()
 			r := strings.NewReader(tt.input)
 			var w bytes.Buffer
-			printer := VersionPrinter{DarkBackground: tt.darkBackground}
+			printer := VersionPrinter{Theme: ThemeForBackground(tt.darkBackground)}
 			printer.Print(r, &w)
 			testutil.MustEqual(t, tt.expected, w.String())
 		})
@@ -66,7 +66,7 @@ func Test_VersionShortPrinter_Print(t *testing.T) {
 			t.Parallel()
 			r := strings.NewReader(tt.input)
 			var w bytes.Buffer
-			printer := VersionShortPrinter{DarkBackground: tt.darkBackground}
+			printer := VersionShortPrinter{Theme: ThemeForBackground(tt.darkBackground)}
 			printer.Print(r, &w)
 			testutil.MustEqual(t, tt.expected, w.String())
 		})
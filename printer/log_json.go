@@ -0,0 +1,133 @@
+package printer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hidetatz/kubecolor/color"
+)
+
+// jsonLogField is a field of a JSON log line. The fields are kept in the order they are written.
+type jsonLogField struct {
+	key   string
+	value json.RawMessage
+}
+
+// parseJSONLogLine parses line as a JSON object. ok is false when line is not a JSON object.
+func parseJSONLogLine(line string) (fields []jsonLogField, ok bool) {
+	if !strings.HasPrefix(strings.TrimSpace(line), "{") {
+		return nil, false
+	}
+
+	dec := json.NewDecoder(strings.NewReader(line))
+	if t, err := dec.Token(); err != nil || t != json.Delim('{') {
+		return nil, false
+	}
+
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return nil, false
+		}
+
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return nil, false
+		}
+
+		fields = append(fields, jsonLogField{key: t.(string), value: value})
+	}
+
+	if t, err := dec.Token(); err != nil || t != json.Delim('}') {
+		return nil, false
+	}
+
+	// something after the object means the line is not JSON e.g. "{...} and more"
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, false
+	}
+
+	return fields, true
+}
+
+// printJSONLine prints a JSON log line as "<timestamp> <level> <message> key=value ..." and returns its level.
+// ok is false when line is not a JSON object, then nothing is printed.
+func (lp *LogsPrinter) printJSONLine(w io.Writer, line string) (level logLevel, ok bool) {
+	fields, ok := parseJSONLogLine(line)
+	if !ok {
+		return logLevelNone, false
+	}
+
+	var parts []string
+
+	if ts, ok := takeJSONLogField(&fields, logTimestampKeys); ok {
+		parts = append(parts, color.Apply(jsonLogString(ts), lp.Theme.Logs.Timestamp))
+	}
+
+	if lv, ok := takeJSONLogField(&fields, logLevelKeys); ok {
+		s := jsonLogString(lv)
+		level = logLevelWords[strings.ToUpper(s)]
+		parts = append(parts, color.Apply(s, lp.levelStyle(level)))
+	}
+
+	if msg, ok := takeJSONLogField(&fields, logMessageKeys); ok {
+		parts = append(parts, color.Apply(jsonLogString(msg), lp.Theme.Logs.Message))
+	}
+
+	for _, f := range fields {
+		value := jsonLogValue(f.value)
+		parts = append(parts, fmt.Sprintf("%s=%s",
+			color.Apply(f.key, lp.Theme.Key[0]),
+			color.Apply(value, getColorByValueType(value, lp.Theme)),
+		))
+	}
+
+	fmt.Fprintf(w, "%s\n", strings.Join(parts, " "))
+	return level, true
+}
+
+// takeJSONLogField removes the first field whose key is one of keys from fields and returns its value.
+func takeJSONLogField(fields *[]jsonLogField, keys []string) (json.RawMessage, bool) {
+	for i, f := range *fields {
+		for _, key := range keys {
+			if f.key == key {
+				*fields = append((*fields)[:i], (*fields)[i+1:]...)
+				return f.value, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// jsonLogString returns the string value without quotes. Other values are returned as they are written.
+func jsonLogString(value json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(value, &s); err == nil {
+		return s
+	}
+
+	return jsonLogValue(value)
+}
+
+// jsonLogValue returns the value to be shown in key=value.
+// Strings are unquoted unless the quotes are needed to tell where the value ends.
+func jsonLogValue(value json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(value, &s); err == nil {
+		if s == "" || strings.ContainsAny(s, " =\"") {
+			return string(value)
+		}
+		return s
+	}
+
+	var b bytes.Buffer
+	if err := json.Compact(&b, value); err != nil {
+		return string(value)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,51 @@
+package printer
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/hidetatz/kubecolor/color"
+)
+
+// klogLine is a line written by klog (https://github.com/kubernetes/klog), e.g.
+//
+//	I0102 15:04:05.123456       1 controller.go:42] "Starting workers" count=2
+//
+// The groups are the level, the time, the thread id, the caller and the message.
+var klogLine = regexp.MustCompile(`^([IWEF])(\d{4} \d{2}:\d{2}:\d{2}\.\d{6})(\s+\d+ )([^ \]]+:\d+)\] (.*)$`)
+
+// printKlogLine prints a klog line with its header fields, message and key/value pairs colored, and returns its level.
+// The message of structured logging is quoted and followed by key=value pairs,
+// otherwise the whole text after the header is the message.
+// ok is false when line is not klog, then nothing is printed.
+func (lp *LogsPrinter) printKlogLine(w io.Writer, line string) (level logLevel, ok bool) {
+	m := klogLine.FindStringSubmatch(line)
+	if m == nil {
+		return logLevelNone, false
+	}
+
+	level = klogLevels[m[1]]
+	fmt.Fprintf(w, "%s%s%s%s] ",
+		color.Apply(m[1], lp.levelStyle(level)),
+		color.Apply(m[2], lp.Theme.Logs.Timestamp),
+		m[3],
+		color.Apply(m[4], lp.Theme.Logs.Caller),
+	)
+
+	msg := m[5]
+	if strings.HasPrefix(msg, `"`) {
+		if end := closingQuote(msg); end > 0 {
+			if pairs, trailing, ok := parseLogfmt(msg[end+1:]); ok {
+				fmt.Fprintf(w, "%s", color.Apply(msg[:end+1], lp.Theme.Logs.Message))
+				lp.printLogfmtPairs(w, pairs)
+				fmt.Fprintf(w, "%s\n", trailing)
+				return level, true
+			}
+		}
+	}
+
+	fmt.Fprintf(w, "%s\n", color.Apply(msg, lp.Theme.Logs.Message))
+	return level, true
+}
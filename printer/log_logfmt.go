@@ -0,0 +1,129 @@
+package printer
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/hidetatz/kubecolor/color"
+)
+
+// logfmtPair is a key=value pair of logfmt (https://brandur.org/logfmt).
+type logfmtPair struct {
+	space string // the spaces before the pair
+	key   string
+	value string // as it's written, with the quotes if quoted
+}
+
+// parseLogfmt parses s as logfmt pairs like `level=info msg="server started" port=8080`.
+// trailing is the spaces after the last pair. ok is false when s is not logfmt.
+func parseLogfmt(s string) (pairs []logfmtPair, trailing string, ok bool) {
+	for {
+		rest := strings.TrimLeft(s, " ")
+		space := s[:len(s)-len(rest)]
+		if rest == "" {
+			return pairs, space, true
+		}
+
+		eq := strings.IndexByte(rest, '=')
+		if eq <= 0 || strings.ContainsAny(rest[:eq], ` "`) {
+			return nil, "", false
+		}
+
+		key := rest[:eq]
+		rest = rest[eq+1:]
+
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			end := closingQuote(rest)
+			if end < 0 {
+				return nil, "", false
+			}
+			value = rest[:end+1]
+		} else {
+			end := strings.IndexByte(rest, ' ')
+			if end < 0 {
+				end = len(rest)
+			}
+			value = rest[:end]
+			if strings.Contains(value, `"`) {
+				return nil, "", false
+			}
+		}
+
+		pairs = append(pairs, logfmtPair{space: space, key: key, value: value})
+		s = rest[len(value):]
+	}
+}
+
+// closingQuote returns the index of the quote closing the string s starts with, or -1 if it's not closed.
+func closingQuote(s string) int {
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++ // skip the escaped character
+		case '"':
+			return i
+		}
+	}
+
+	return -1
+}
+
+// printLogfmtLine prints a logfmt line with its keys and values colored and returns its level.
+// A line needs at least 2 pairs to be logfmt, so that a message containing "=" is not taken as logfmt.
+// ok is false when line is not logfmt, then nothing is printed.
+func (lp *LogsPrinter) printLogfmtLine(w io.Writer, line string) (level logLevel, ok bool) {
+	pairs, trailing, ok := parseLogfmt(line)
+	if !ok || len(pairs) < 2 {
+		return logLevelNone, false
+	}
+
+	level = lp.printLogfmtPairs(w, pairs)
+	fmt.Fprintf(w, "%s\n", trailing)
+	return level, true
+}
+
+// printLogfmtPairs prints pairs and returns the level found in them.
+// The values of the well-known keys (level, time and message) get their own colors,
+// and the others are colored by their types.
+func (lp *LogsPrinter) printLogfmtPairs(w io.Writer, pairs []logfmtPair) logLevel {
+	level := logLevelNone
+	for _, p := range pairs {
+		var c color.Style
+		switch {
+		case containsString(logLevelKeys, p.key):
+			level = logLevelWords[strings.ToUpper(unquoteLogfmtValue(p.value))]
+			c = lp.levelStyle(level)
+		case containsString(logTimestampKeys, p.key):
+			c = lp.Theme.Logs.Timestamp
+		case containsString(logMessageKeys, p.key):
+			c = lp.Theme.Logs.Message
+		default:
+			c = getColorByValueType(p.value, lp.Theme)
+		}
+
+		fmt.Fprintf(w, "%s%s=%s", p.space, color.Apply(p.key, lp.Theme.Key[0]), color.Apply(p.value, c))
+	}
+
+	return level
+}
+
+func unquoteLogfmtValue(value string) string {
+	if s, err := strconv.Unquote(value); err == nil {
+		return s
+	}
+
+	return value
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
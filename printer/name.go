@@ -0,0 +1,30 @@
+package printer
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hidetatz/kubecolor/color"
+)
+
+// NamePrinter is a printer for -o name output.
+// Each line is "kind/name" e.g. "deployment.apps/nginx", and the kind and the name are shown in different colors.
+type NamePrinter struct {
+	Theme *Theme
+}
+
+func (np *NamePrinter) Print(r io.Reader, w io.Writer) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		kind, name, ok := strings.Cut(line, "/")
+		if !ok {
+			fmt.Fprintf(w, "%s\n", color.Apply(line, np.Theme.Name))
+			continue
+		}
+
+		fmt.Fprintf(w, "%s/%s\n", color.Apply(kind, np.Theme.Kind), color.Apply(name, np.Theme.Name))
+	}
+}
@@ -0,0 +1,50 @@
+package printer
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/hidetatz/kubecolor/testutil"
+)
+
+func Test_NamePrinter_Print(t *testing.T) {
+	tests := []struct {
+		name           string
+		darkBackground bool
+		input          string
+		expected       string
+	}{
+		{
+			name:           "kind and name are colored",
+			darkBackground: true,
+			input: testutil.NewHereDoc(`
+				deployment.apps/nginx
+				service/nginx`),
+			expected: testutil.NewHereDoc(`
+				[36mdeployment.apps[0m/[32mnginx[0m
+				[36mservice[0m/[32mnginx[0m
+			`),
+		},
+		{
+			name:           "name only",
+			darkBackground: false,
+			input: testutil.NewHereDoc(`
+				nginx`),
+			expected: testutil.NewHereDoc(`
+				[32mnginx[0m
+			`),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := strings.NewReader(tt.input)
+			var w bytes.Buffer
+			printer := NamePrinter{Theme: ThemeForBackground(tt.darkBackground)}
+			printer.Print(r, &w)
+			testutil.MustEqual(t, tt.expected, w.String())
+		})
+	}
+}
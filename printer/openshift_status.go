@@ -12,9 +12,15 @@ import (
 
 // OpenShiftStatusPrinter prints the output of 'oc status' with colors.
 type OpenShiftStatusPrinter struct {
-	DarkBackground bool
+	Theme *Theme
+	// Statuses tells the severities of the statuses in the text e.g. "running" and "failed".
+	// The default statuses are used when it's nil.
+	Statuses StatusRegistry
 }
 
+// openShiftStatusWord is a word which can be a status e.g. "running" in "deployment #2 running for 5 minutes"
+var openShiftStatusWord = regexp.MustCompile(`\b[a-z]+\b`)
+
 // Print reads r then write it to w with colors.
 func (p *OpenShiftStatusPrinter) Print(r io.Reader, w io.Writer) {
 	scanner := bufio.NewScanner(r)
@@ -27,43 +33,36 @@ func (p *OpenShiftStatusPrinter) Print(r io.Reader, w io.Writer) {
 func (p *OpenShiftStatusPrinter) colorizeLine(line string) string {
 	// Project context
 	if strings.HasPrefix(line, "In project ") {
-		return color.Apply(line, color.Cyan)
+		return color.Apply(line, p.Theme.OpenShift.Project)
 	}
 
 	// Service names (e.g., svc/service-name)
 	reService := regexp.MustCompile(`(svc/\S+)`)
 	line = reService.ReplaceAllStringFunc(line, func(match string) string {
-		return color.Apply(match, color.Green)
+		return color.Apply(match, p.Theme.OpenShift.Service)
 	})
 
 	// Deployment config names (e.g., dc/deployment-config-name)
 	reDc := regexp.MustCompile(`(dc/\S+)`)
 	line = reDc.ReplaceAllStringFunc(line, func(match string) string {
-		return color.Apply(match, color.Blue)
+		return color.Apply(match, p.Theme.OpenShift.DeploymentConfig)
 	})
 
 	// URLs / routes
 	// A simple regex for URLs, might need refinement
 	reURL := regexp.MustCompile(`(https?://[^\s]+)`)
 	line = reURL.ReplaceAllStringFunc(line, func(match string) string {
-		return color.Apply(match, color.Magenta)
+		return color.Apply(match, p.Theme.OpenShift.URL)
 	})
 
-	// Keywords
-	// Using regex with word boundaries to avoid partial matches (e.g. "deployment" contains "deploy")
-	keywords := map[string]color.Color{
-		`\brunning\b`:  color.Green,
-		`\bdeployed\b`: color.Green,
-		`\bfailed\b`:   color.Red,
-		// Add more keywords as needed
-	}
-
-	for keyword, c := range keywords {
-		reKeyword := regexp.MustCompile(keyword)
-		line = reKeyword.ReplaceAllStringFunc(line, func(match string) string {
+	// Statuses
+	// oc status writes them in lower case, so the words in upper case (e.g. "Error" in a name) are left as is
+	line = openShiftStatusWord.ReplaceAllStringFunc(line, func(match string) string {
+		if c, ok := p.Statuses.style(match, p.Theme); ok {
 			return color.Apply(match, c)
-		})
-	}
+		}
+		return match
+	})
 
 	return line
 }
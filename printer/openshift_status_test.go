@@ -61,6 +61,12 @@ func TestOpenShiftStatusPrinter_Print(t *testing.T) {
 			darkBackground: true,
 			expectedOutput: "Pod my-pod-1-build: build " + color.Apply("failed", color.Red) + "\n",
 		},
+		{
+			name:           "keyword pending",
+			input:          "  deployment #3 pending 10 seconds ago",
+			darkBackground: true,
+			expectedOutput: "  deployment #3 " + color.Apply("pending", color.Yellow) + " 10 seconds ago\n",
+		},
 		{
 			name: "mixed output",
 			input: `In project default on server https://localhost:8443
@@ -93,7 +99,7 @@ View details with 'oc describe <resource>/<name>' or list everything with 'oc ge
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			var buf bytes.Buffer
-			printer := OpenShiftStatusPrinter{DarkBackground: tt.darkBackground}
+			printer := OpenShiftStatusPrinter{Theme: ThemeForBackground(tt.darkBackground)}
 			printer.Print(strings.NewReader(tt.input), &buf)
 
 			testutil.MustEqual(t, tt.expectedOutput, buf.String())
@@ -0,0 +1,54 @@
+package printer
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hidetatz/kubecolor/color"
+)
+
+// ResourceActionPrinter is a printer for the output which tells what was done to the resources.
+// e.g.
+//
+//	certificatesigningrequest.certificates.k8s.io/csr-8b2pj approved
+//	deployment.apps/nginx image updated (dry run)
+type ResourceActionPrinter struct {
+	Theme *Theme
+	// Actions are the colors of the actions, keyed by the words after the resource name.
+	Actions map[string]color.Style
+}
+
+func (rp *ResourceActionPrinter) Print(r io.Reader, w io.Writer) {
+	const dryRunStr = " (dry run)"
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSuffix(line, dryRunStr)
+		dryRun := trimmed != line
+
+		if !rp.printAction(w, trimmed, dryRun) {
+			fmt.Fprintf(w, "%s\n", color.Apply(line, rp.Theme.Default))
+		}
+	}
+}
+
+// printAction prints line with its action colored. It returns false when line has no known action.
+func (rp *ResourceActionPrinter) printAction(w io.Writer, line string, dryRun bool) bool {
+	for action, c := range rp.Actions {
+		if !strings.HasSuffix(line, " "+action) {
+			continue
+		}
+
+		fmt.Fprintf(w, "%s %s", strings.TrimSuffix(line, " "+action), color.Apply(action, c))
+		if dryRun {
+			fmt.Fprintf(w, " %s", color.Apply("(dry run)", rp.Theme.Apply.DryRun))
+		}
+		fmt.Fprintln(w)
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,81 @@
+package printer
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/hidetatz/kubecolor/color"
+	"github.com/hidetatz/kubecolor/testutil"
+)
+
+func Test_ResourceActionPrinter_Print(t *testing.T) {
+	tests := []struct {
+		name           string
+		darkBackground bool
+		actions        func(theme *Theme) map[string]color.Style
+		input          string
+		expected       string
+	}{
+		{
+			name:           "approved",
+			darkBackground: true,
+			actions: func(theme *Theme) map[string]color.Style {
+				return map[string]color.Style{"approved": theme.Success, "denied": theme.Error}
+			},
+			input: testutil.NewHereDoc(`
+				certificatesigningrequest.certificates.k8s.io/csr-8b2pj approved`),
+			expected: testutil.NewHereDoc(`
+				certificatesigningrequest.certificates.k8s.io/csr-8b2pj [32mapproved[0m
+			`),
+		},
+		{
+			name:           "denied",
+			darkBackground: true,
+			actions: func(theme *Theme) map[string]color.Style {
+				return map[string]color.Style{"approved": theme.Success, "denied": theme.Error}
+			},
+			input: testutil.NewHereDoc(`
+				certificatesigningrequest.certificates.k8s.io/csr-8b2pj denied`),
+			expected: testutil.NewHereDoc(`
+				certificatesigningrequest.certificates.k8s.io/csr-8b2pj [31mdenied[0m
+			`),
+		},
+		{
+			name:           "dry run",
+			darkBackground: true,
+			actions: func(theme *Theme) map[string]color.Style {
+				return map[string]color.Style{"image updated": theme.Apply.Configured}
+			},
+			input: testutil.NewHereDoc(`
+				deployment.apps/nginx image updated (dry run)`),
+			expected: testutil.NewHereDoc(`
+				deployment.apps/nginx [33mimage updated[0m [36m(dry run)[0m
+			`),
+		},
+		{
+			name:           "unknown action",
+			darkBackground: true,
+			actions: func(theme *Theme) map[string]color.Style {
+				return map[string]color.Style{"image updated": theme.Apply.Configured}
+			},
+			input: testutil.NewHereDoc(`
+				error: unable to find container named "foo"`),
+			expected: testutil.NewHereDoc(`
+				[32merror: unable to find container named "foo"[0m
+			`),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := strings.NewReader(tt.input)
+			var w bytes.Buffer
+			theme := ThemeForBackground(tt.darkBackground)
+			printer := ResourceActionPrinter{Theme: theme, Actions: tt.actions(theme)}
+			printer.Print(r, &w)
+			testutil.MustEqual(t, tt.expected, w.String())
+		})
+	}
+}
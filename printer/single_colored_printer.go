@@ -10,7 +10,7 @@ import (
 
 // SingleColoredPrinter is a printer to print something in pre-cofigured color.
 type SingleColoredPrinter struct {
-	Color color.Color
+	Color color.Styler
 }
 
 // Print reads r then writes it in w in sp.Color
@@ -0,0 +1,40 @@
+package printer
+
+import (
+	"hash/fnv"
+	"regexp"
+	"strings"
+
+	"github.com/hidetatz/kubecolor/color"
+)
+
+// ownerSuffix is the suffix which Kubernetes generates for the names of the resources made by a controller:
+// the 5 random letters of a pod e.g. "-kbwcl" in "fluentd-kbwcl", the pod-template-hash of a ReplicaSet
+// e.g. "-6799fc88d8" in "nginx-6799fc88d8", or both e.g. "-6799fc88d8-dnmv5" in "nginx-6799fc88d8-dnmv5".
+// The letters are the ones Kubernetes uses for generated strings, which have no vowels and no 0, 1 and 3.
+var ownerSuffix = regexp.MustCompile(`-(?:[bcdfghjklmnpqrstvwxz2456789]{9,10}(?:-[bcdfghjklmnpqrstvwxz2456789]{5})?|[bcdfghjklmnpqrstvwxz2456789]{5})$`)
+
+// stableColor picks a color from palette by the hash of s.
+// The same s always gets the same color, even between runs, so a pod can be followed by its color.
+func stableColor(s string, palette []color.Style) color.Style {
+	if len(palette) == 0 {
+		return color.Style{}
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(s)) // never returns an error
+	return palette[h.Sum32()%uint32(len(palette))]
+}
+
+// ownerPrefix returns the name without the suffixes generated by its owners, e.g. "nginx" for "pod/nginx-6799fc88d8-dnmv5"
+// and "replicaset.apps/nginx-6799fc88d8", so the resources made from the same deployment get the same stable color.
+// The ordinals of StatefulSet pods are kept because they can't be told from the names like "node-7",
+// and so are the other suffixes which don't look generated e.g. "-v2beta" in "api-v2beta".
+func ownerPrefix(name string) string {
+	// e.g. "pod/" in kubectl get pod,rs
+	if i := strings.LastIndex(name, "/"); i != -1 {
+		name = name[i+1:]
+	}
+
+	return ownerSuffix.ReplaceAllString(name, "")
+}
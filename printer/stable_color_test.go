@@ -0,0 +1,73 @@
+package printer
+
+import (
+	"testing"
+
+	"github.com/hidetatz/kubecolor/color"
+	"github.com/hidetatz/kubecolor/testutil"
+)
+
+func Test_stableColor(t *testing.T) {
+	palette := []color.Style{
+		{Fg: color.Cyan},
+		{Fg: color.Green},
+		{Fg: color.Magenta},
+		{Fg: color.White},
+		{Fg: color.Yellow},
+	}
+
+	tests := []struct {
+		name     string
+		s        string
+		palette  []color.Style
+		expected color.Style
+	}{
+		{"picked by hash", "[pod/api-0/api]", palette, color.Style{Fg: color.Green}},
+		{"another string", "[pod/api-1/api]", palette, color.Style{Fg: color.Magenta}},
+		{"pod with container", "[pod/nginx-6799fc88d8-dnmv5/nginx]", palette, color.Style{Fg: color.White}},
+		{"empty palette", "[pod/api-0/api]", nil, color.Style{}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			testutil.MustEqual(t, tt.expected, stableColor(tt.s, tt.palette))
+			// it must not change between calls
+			testutil.MustEqual(t, stableColor(tt.s, tt.palette), stableColor(tt.s, tt.palette))
+		})
+	}
+}
+
+func Test_ownerPrefix(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{"nginx-6799fc88d8-dnmv5", "nginx"},
+		{"pod/nginx-6799fc88d8-dnmv5", "nginx"},
+		{"replicaset.apps/nginx-6799fc88d8", "nginx"},
+		{"fluentd-x7k2p", "fluentd"},                 // DaemonSet
+		{"backup-28312345-mq4vz", "backup-28312345"}, // CronJob
+		{"api-0", "api-0"},                           // StatefulSet
+		{"node-7", "node-7"},                         // node
+		{"kube-system", "kube-system"},               // namespace
+		{"coredns-5d78c9869d-kbwcl", "coredns"},      // kube-system
+		{"kube-apiserver-minikube", "kube-apiserver-minikube"},
+		// the suffixes which are not generated by Kubernetes are kept
+		{"api-v2beta", "api-v2beta"},
+		{"pod/api-v2beta", "api-v2beta"},
+		{"web-frontend", "web-frontend"},
+		{"cache-7d9f", "cache-7d9f"},
+		{"app-bcdfghj", "app-bcdfghj"},
+		{"billing-28312345", "billing-28312345"},
+		{"nginx-6799fc88d8-dnmv5x", "nginx-6799fc88d8-dnmv5x"},
+		{"nginx-6799fc3d8-dnmv5", "nginx-6799fc3d8"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			testutil.MustEqual(t, tt.expected, ownerPrefix(tt.name))
+		})
+	}
+}
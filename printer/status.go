@@ -0,0 +1,139 @@
+package printer
+
+import (
+	"strings"
+
+	"github.com/hidetatz/kubecolor/color"
+)
+
+// StatusSeverity is how healthy a resource is when it's in a status.
+type StatusSeverity string
+
+const (
+	// StatusHealthy is for the statuses which need no attention e.g. "Running". They are shown in the success color.
+	StatusHealthy StatusSeverity = "healthy"
+	// StatusWarning is for the statuses which are on the way or need some attention e.g. "Pending". They are shown in the warning color.
+	StatusWarning StatusSeverity = "warning"
+	// StatusUnhealthy is for the statuses which something went wrong e.g. "CrashLoopBackOff". They are shown in the error color.
+	StatusUnhealthy StatusSeverity = "unhealthy"
+)
+
+// StatusSeverities is the list of the severities, which can be the keys of statuses in the config file.
+var StatusSeverities = []StatusSeverity{StatusHealthy, StatusWarning, StatusUnhealthy}
+
+// defaultStatuses are the status words of Kubernetes resources and their events, e.g. pods, nodes and persistent volumes.
+var defaultStatuses = map[StatusSeverity][]string{
+	StatusHealthy: {
+		"Running", "Ready", "Bound", "Completed", "Complete", "Succeeded", "Active", "Available", "Healthy",
+		"Established", "Deployed", "Normal", "PodCompleted",
+	},
+	StatusWarning: {
+		"Pending", "ContainerCreating", "PodInitializing", "Terminating", "Waiting", "SchedulingDisabled",
+		"Released", "Progressing", "Warning", "ContainersNotReady",
+	},
+	StatusUnhealthy: {
+		"Failed", "Error", "CrashLoopBackOff", "OOMKilled", "Evicted", "ErrImagePull", "ImagePullBackOff",
+		"InvalidImageName", "CreateContainerConfigError", "CreateContainerError", "RunContainerError",
+		"ContainerStatusUnknown", "NotReady", "Unknown", "Lost", "BackOff", "Unhealthy", "Degraded",
+		"FailedScheduling", "FailedMount", "FailedCreate", "Unschedulable",
+	},
+}
+
+// StatusRegistry maps status words to their severities. The words are case-insensitive.
+// The nil registry has the default statuses only.
+type StatusRegistry map[string]StatusSeverity
+
+// NewStatusRegistry returns the registry which has the default statuses and extra.
+// A word in extra overrides the severity of the same default word.
+func NewStatusRegistry(extra map[StatusSeverity][]string) StatusRegistry {
+	sr := StatusRegistry{}
+	for _, statuses := range []map[StatusSeverity][]string{defaultStatuses, extra} {
+		for severity, words := range statuses {
+			for _, word := range words {
+				sr[strings.ToLower(word)] = severity
+			}
+		}
+	}
+
+	return sr
+}
+
+var defaultStatusRegistry = NewStatusRegistry(nil)
+
+// Severity returns the severity of status. ok is false when status is not a known word.
+func (sr StatusRegistry) Severity(status string) (severity StatusSeverity, ok bool) {
+	if sr == nil {
+		sr = defaultStatusRegistry
+	}
+
+	severity, ok = sr[strings.ToLower(status)]
+	return severity, ok
+}
+
+// style returns the style of status in theme. ok is false when status is not a known word.
+func (sr StatusRegistry) style(status string, theme *Theme) (color.Style, bool) {
+	severity, ok := sr.Severity(status)
+	if !ok {
+		return color.Style{}, false
+	}
+
+	return severityStyle(severity, theme), true
+}
+
+func severityStyle(severity StatusSeverity, theme *Theme) color.Style {
+	switch severity {
+	case StatusHealthy:
+		return theme.Success
+	case StatusWarning:
+		return theme.Warning
+	case StatusUnhealthy:
+		return theme.Error
+	}
+
+	return color.Style{}
+}
+
+// severityRank returns how severe severity is. The larger is the more severe.
+func severityRank(severity StatusSeverity) int {
+	for i, s := range StatusSeverities {
+		if s == severity {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// abnormalConditionSuffixes are the suffixes of the condition types which are true when something is wrong,
+// e.g. "MemoryPressure" of nodes and "ReplicaFailure" of deployments. The other conditions are healthy when they are true e.g. "Ready".
+var abnormalConditionSuffixes = []string{"Pressure", "Unavailable", "Failure", "Failed"}
+
+// conditionStyle returns the style of the status of a condition e.g. "False" of "Ready".
+// "True" and "False" are healthy or unhealthy by conditionType, and the other statuses e.g. "Unknown" are looked up in the registry.
+// ok is false when status is not a known word.
+func (sr StatusRegistry) conditionStyle(conditionType, status string, theme *Theme) (color.Style, bool) {
+	healthy, unhealthy := StatusHealthy, StatusUnhealthy
+	for _, suffix := range abnormalConditionSuffixes {
+		if strings.HasSuffix(conditionType, suffix) {
+			healthy, unhealthy = StatusUnhealthy, StatusHealthy
+			break
+		}
+	}
+
+	switch status {
+	case "True":
+		return severityStyle(healthy, theme), true
+	case "False":
+		return severityStyle(unhealthy, theme), true
+	}
+
+	return sr.style(status, theme)
+}
+
+// statusDeciderFn returns the function for TablePrinter.ColorDeciderFn
+// which colors the columns consisting of a status word, e.g. "Running" in kubectl get --no-headers.
+func statusDeciderFn(theme *Theme, statuses StatusRegistry) func(index int, column string) (color.Style, bool) {
+	return func(_ int, column string) (color.Style, bool) {
+		return statuses.style(column, theme)
+	}
+}
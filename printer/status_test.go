@@ -0,0 +1,64 @@
+package printer
+
+import (
+	"testing"
+
+	"github.com/hidetatz/kubecolor/color"
+	"github.com/hidetatz/kubecolor/testutil"
+)
+
+func Test_StatusRegistry_Severity(t *testing.T) {
+	tests := []struct {
+		name             string
+		registry         StatusRegistry
+		status           string
+		expectedSeverity StatusSeverity
+		expectedOK       bool
+	}{
+		{"healthy", nil, "Running", StatusHealthy, true},
+		{"warning", nil, "ContainerCreating", StatusWarning, true},
+		{"unhealthy", nil, "ImagePullBackOff", StatusUnhealthy, true},
+		{"case-insensitive", nil, "failed", StatusUnhealthy, true},
+		{"unknown word", nil, "nginx", "", false},
+		{"extended", NewStatusRegistry(map[StatusSeverity][]string{StatusUnhealthy: {"Degraded", "OutOfSync"}}), "OutOfSync", StatusUnhealthy, true},
+		{"defaults are kept when extended", NewStatusRegistry(map[StatusSeverity][]string{StatusUnhealthy: {"OutOfSync"}}), "Running", StatusHealthy, true},
+		{"default word is overridden", NewStatusRegistry(map[StatusSeverity][]string{StatusWarning: {"Completed"}}), "Completed", StatusWarning, true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			severity, ok := tt.registry.Severity(tt.status)
+			testutil.MustEqual(t, tt.expectedSeverity, severity)
+			testutil.MustEqual(t, tt.expectedOK, ok)
+		})
+	}
+}
+
+func Test_StatusRegistry_conditionStyle(t *testing.T) {
+	theme := ThemeForBackground(true)
+	tests := []struct {
+		conditionType string
+		status        string
+		expected      color.Style
+		expectedOK    bool
+	}{
+		{"Ready", "True", theme.Success, true},
+		{"Ready", "False", theme.Error, true},
+		{"Ready", "Unknown", theme.Error, true},
+		{"MemoryPressure", "False", theme.Success, true},
+		{"MemoryPressure", "True", theme.Error, true},
+		{"NetworkUnavailable", "False", theme.Success, true},
+		{"ReplicaFailure", "True", theme.Error, true},
+		{"Available", "maybe", color.Style{}, false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.conditionType+"="+tt.status, func(t *testing.T) {
+			t.Parallel()
+			style, ok := StatusRegistry(nil).conditionStyle(tt.conditionType, tt.status, theme)
+			testutil.MustEqual(t, tt.expected, style)
+			testutil.MustEqual(t, tt.expectedOK, ok)
+		})
+	}
+}
@@ -10,54 +10,83 @@ import (
 )
 
 type TablePrinter struct {
-	WithHeader     bool
-	DarkBackground bool
-	ColorDeciderFn func(index int, column string) (color.Color, bool)
+	WithHeader bool
+	Theme      *Theme
+	// ColorDeciderFn decides the colors of the columns whose roles are not told by the header,
+	// e.g. when the table has no header.
+	ColorDeciderFn func(index int, column string) (color.Style, bool)
+	// Statuses tells the severities of the values in STATUS column. The default statuses are used when it's nil.
+	Statuses StatusRegistry
+	// Thresholds decides the colors of the numbers in RESTARTS and AGE columns. The default thresholds are used when it's nil.
+	Thresholds *Thresholds
+	// LabelColumns are the label keys given by -L, whose columns are shown as label values.
+	LabelColumns []string
+	// StableColors colors NAMESPACE, NODE and the owners of NAME by the hash of their values,
+	// so the same values get the same colors in every table.
+	StableColors bool
 
 	isFirstLine   bool
-	indexColorMap map[int]color.Color
-	tempColors    []color.Color
+	columns       []tableColumn // the columns in the last header
+	indexColorMap map[int]color.Style
+	tempColors    []color.Style
 }
 
-func NewTablePrinter(withHeader, darkBackground bool, colorDeciderFn func(index int, column string) (color.Color, bool)) *TablePrinter {
+func NewTablePrinter(withHeader bool, theme *Theme, colorDeciderFn func(index int, column string) (color.Style, bool)) *TablePrinter {
 	return &TablePrinter{
 		WithHeader:     withHeader,
-		DarkBackground: darkBackground,
+		Theme:          theme,
 		ColorDeciderFn: colorDeciderFn,
-		indexColorMap:  map[int]color.Color{},
-		tempColors:     []color.Color{},
+		isFirstLine:    true,
+		indexColorMap:  map[int]color.Style{},
+		tempColors:     []color.Style{},
 	}
 }
 
 func (tp *TablePrinter) Print(r io.Reader, w io.Writer) {
-	tp.isFirstLine = true
+	tp.startTable()
 	scanner := bufio.NewScanner(r)
 	for scanner.Scan() {
-		line := scanner.Text()
-		if tp.isHeader(line) {
-			fmt.Fprintf(w, "%s\n", color.Apply(line, getHeaderColorByBackground(tp.DarkBackground)))
-			tp.isFirstLine = false
-			continue
-		}
+		tp.printLine(w, scanner.Text())
+	}
+}
+
+// printLine prints a line of a table, which can be the header.
+// Several tables can be printed at once, separated by a blank line, and each table has its own header.
+// e.g.
+// kubecolor get pod,rs
+// NAME                         READY   STATUS    RESTARTS   AGE
+// pod/nginx-8spn9              1/1     Running   1          19d
+// pod/nginx-dplns              1/1     Running   1          19d
+// pod/nginx-lpv5x              1/1     Running   1          19d
+//
+// NAME                               DESIRED   CURRENT   READY   AGE <- this
+// replicaset.apps/nginx              3         3         3       19d
+// replicaset.apps/nginx-6799fc88d8   3         3         3       19d
+func (tp *TablePrinter) printLine(w io.Writer, line string) {
+	if strings.TrimSpace(line) == "" {
+		fmt.Fprintf(w, "%s\n", line)
+		tp.startTable()
+		return
+	}
 
-		tp.printLineAsTableFormat(w, line, getColorsByBackground(tp.DarkBackground))
+	if tp.WithHeader && tp.isFirstLine {
+		fmt.Fprintf(w, "%s\n", color.Apply(line, tp.Theme.Header))
+		tp.columns = parseTableHeader(line)
+		markLabelColumns(tp.columns, tp.LabelColumns)
+		tp.isFirstLine = false
+		return
 	}
+
+	tp.isFirstLine = false
+	tp.printLineAsTableFormat(w, line, tp.Theme.Table)
 }
 
-func (tp *TablePrinter) isHeader(line string) bool {
-	// If every character is upper case, probably it's a header line.
-	// e.g.
-	// kubecolor get pod,rs
-	// NAME                         READY   STATUS    RESTARTS   AGE
-	// pod/nginx-8spn9              1/1     Running   1          19d
-	// pod/nginx-dplns              1/1     Running   1          19d
-	// pod/nginx-lpv5x              1/1     Running   1          19d
-
-	// NAME                               DESIRED   CURRENT   READY   AGE <- this
-	// replicaset.apps/nginx              3         3         3       19d
-	// replicaset.apps/nginx-6799fc88d8   3         3         3       19d
-	isEveryCharacterUpper := strings.ToUpper(line) == line
-	return (tp.WithHeader && tp.isFirstLine) || isEveryCharacterUpper
+// startTable makes the next line the first line of a new table, whose columns are colored from scratch.
+func (tp *TablePrinter) startTable() {
+	tp.isFirstLine = true
+	tp.columns = nil
+	tp.indexColorMap = map[int]color.Style{}
+	tp.tempColors = []color.Style{}
 }
 
 // printTableFormat prints a line to w in kubectl "table" Format.
@@ -72,38 +101,77 @@ func (tp *TablePrinter) isHeader(line string) bool {
 // nginx-lpv5x              1/1     Running   0          31h
 // ---------------------------------------------------------
 // This function requires a line and tries to colorize it by each column.
-// If dark is true, use colors which are readable in dark-backgrounded environment, else,
-// it uses colors for light-backgrounded env.
+// Columns are colored with colorsPreset one by one.
 // This function doesn't respect if the line is "header", so
 // if you want to specify a special color for header, you must not pass the line
 // to this function.
-// deciderFn is a function to return context-specific color to be used to decorate a column.
+// When the header of the table has been printed, the columns are colored by the rules of their roles
+// told by the header e.g. an unhealthy status in STATUS column is shown in the error color.
+// deciderFn is a function to return context-specific color to be used to decorate a column whose role is unknown.
 // If the function returned ok=true, then returned color will be used for the column.
 // If it returned ok=false, then default configurated color will be used.
 // If deciderFn is null, then this function uses the default configurated color.
-func (tp *TablePrinter) printLineAsTableFormat(w io.Writer, line string, colorsPreset []color.Color) {
+func (tp *TablePrinter) printLineAsTableFormat(w io.Writer, line string, colorsPreset []color.Style) {
+	if tp.printColumnsByHeader(w, line, colorsPreset) {
+		return
+	}
+
+	tp.printColumns(w, line, 0, colorsPreset)
+}
+
+// printIndentedLineAsTableFormat is printLineAsTableFormat for a line whose first columns are blank,
+// e.g. the contexts which are not current in kubectl config get-contexts.
+// The leading spaces are kept as is, and the columns get the same colors as the columns at the same position in the other lines.
+func (tp *TablePrinter) printIndentedLineAsTableFormat(w io.Writer, line string, colorsPreset []color.Style) {
+	if tp.printColumnsByHeader(w, line, colorsPreset) {
+		return
+	}
+
+	trimmed := strings.TrimLeft(line, " ")
+	indent := len(line) - len(trimmed)
+	if indent == 0 {
+		tp.printLineAsTableFormat(w, line, colorsPreset)
+		return
+	}
+
+	// the blank first column keeps its color as the blank cells in printColumnsByHeader do
+	tp.decideColorForTable(0, colorsPreset)
+	fmt.Fprintf(w, "%s", line[:indent])
+	tp.printColumns(w, trimmed, indent, colorsPreset)
+}
+
+// printColumns colorizes each column in line. offset is the position where line starts in the original line,
+// which is used to decide the colors of the columns.
+func (tp *TablePrinter) printColumns(w io.Writer, line string, offset int, colorsPreset []color.Style) {
 	columns := spaces.Split(line, -1)
 	spacesIndices := spaces.FindAllStringIndex(line, -1)
 
-	if len(columns) == len(spacesIndices)-1 {
-		// It should not come here.
-		panic("kubecolor: unexpected format as table. this must be a bug of kubecolor")
+	if len(columns) != len(spacesIndices)+1 {
+		// It should not come here, but the line is better shown as is than lost.
+		fmt.Fprintf(w, "%s\n", line)
+		return
 	}
 
+	// a line which has a cell for each column in the header is colored as the header tells by the order of the cells,
+	// even when it's not aligned with the header e.g. the lines of kubectl get -w, whose widths are measured for each event
+	byHeader := len(columns) == len(tp.columns)
+
 	for i, column := range columns {
-		index := 0
+		start := offset
 		if i != 0 {
-			index = spacesIndices[i-1][1] + 1
+			start = offset + spacesIndices[i-1][1]
 		}
-
-		c := tp.decideColorForTable(index, colorsPreset)
-		if tp.ColorDeciderFn != nil {
-			if cc, ok := tp.ColorDeciderFn(i, column); ok {
-				c = cc // prior injected deciderFn result
-			}
+		role := tp.roleAt(start)
+		if byHeader {
+			start, role = tp.columns[i].start, tp.columns[i].role
+		}
+		index := 0
+		if start != 0 {
+			index = start + 1
 		}
+
 		// Write colored column
-		fmt.Fprintf(w, "%s", color.Apply(column, c))
+		fmt.Fprintf(w, "%s", tp.colorizeColumn(index, role, i, column, colorsPreset))
 		// Write spaces based on actual output
 		// When writing the most left column, no extra spaces needed.
 		if i <= len(spacesIndices)-1 {
@@ -115,9 +183,74 @@ func (tp *TablePrinter) printLineAsTableFormat(w io.Writer, line string, colorsP
 	fmt.Fprintf(w, "\n")
 }
 
-func (tp *TablePrinter) decideColorForTable(index int, colors []color.Color) color.Color {
+// printColumnsByHeader colorizes each column in line, which is cut at the positions of the columns in the header.
+// Unlike splitting line by spaces, the columns stay in place when some of them are blank
+// (e.g. NOMINATED NODE in kubectl get pod -o wide) or have double spaces in their values.
+// It returns false without printing anything when the header is unknown or line doesn't fit it.
+func (tp *TablePrinter) printColumnsByHeader(w io.Writer, line string, colorsPreset []color.Style) bool {
+	if len(tp.columns) == 0 || tp.columns[0].start != 0 {
+		return false
+	}
+
+	cells := make([]string, len(tp.columns))
+	for i, c := range tp.columns {
+		end := len(line)
+		if i+1 < len(tp.columns) {
+			end = tp.columns[i+1].start
+		}
+		cells[i] = cell(line, c.start, end)
+
+		// a value crossing the start of the column means the line is not aligned with the header,
+		// e.g. a value is wider than kubectl measured, or the line has multibyte characters
+		if i > 0 && c.start < len(line) && line[c.start-1] != ' ' {
+			return false
+		}
+		if value := strings.TrimLeft(cells[i], " "); value != "" && value != cells[i] {
+			return false
+		}
+	}
+
+	for i, c := range tp.columns {
+		index := 0
+		if c.start != 0 {
+			index = c.start + 1
+		}
+
+		value := strings.TrimRight(cells[i], " ")
+		if value == "" {
+			// the color of the position is decided even for a blank cell, so the columns keep their colors whichever row comes first
+			tp.decideColorForTable(index, colorsPreset)
+			fmt.Fprintf(w, "%s", cells[i])
+			continue
+		}
+
+		fmt.Fprintf(w, "%s%s", tp.colorizeColumn(index, c.role, i, value, colorsPreset), cells[i][len(value):])
+	}
+	fmt.Fprintf(w, "\n")
+
+	return true
+}
+
+// decideColumnColor returns the color of column. It's decided by the rule of role,
+// deciderFn when the role is unknown, or the position of the column.
+func (tp *TablePrinter) decideColumnColor(index int, role columnRole, i int, column string, colorsPreset []color.Style) color.Style {
+	c := tp.decideColorForTable(index, colorsPreset)
+	if role != columnRoleNone {
+		if cc, ok := tp.styleForRole(role, column); ok {
+			c = cc
+		}
+	} else if tp.ColorDeciderFn != nil {
+		if cc, ok := tp.ColorDeciderFn(i, column); ok {
+			c = cc // prior injected deciderFn result
+		}
+	}
+
+	return c
+}
+
+func (tp *TablePrinter) decideColorForTable(index int, colors []color.Style) color.Style {
 	if len(tp.tempColors) == 0 {
-		tp.tempColors = make([]color.Color, len(colors))
+		tp.tempColors = make([]color.Style, len(colors))
 		copy(tp.tempColors, colors)
 	}
 
@@ -0,0 +1,221 @@
+package printer
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hidetatz/kubecolor/color"
+)
+
+// columnRole is what the values in a table column mean, which is told by the header of the column.
+type columnRole int
+
+const (
+	columnRoleNone columnRole = iota
+	columnRoleName
+	columnRoleNamespace
+	columnRoleStatus
+	columnRoleReady
+	columnRoleRestarts
+	columnRoleAge
+	columnRoleIP
+	columnRoleNode
+	columnRoleTargets
+	columnRoleImage
+	columnRoleSelector
+	columnRoleLabels
+	columnRoleLabelValue // the columns added by -L
+)
+
+var columnRoles = map[string]columnRole{
+	"NAME":        columnRoleName,
+	"NAMESPACE":   columnRoleNamespace,
+	"STATUS":      columnRoleStatus,
+	"PHASE":       columnRoleStatus,
+	"TYPE":        columnRoleStatus, // e.g. "Warning" in events
+	"REASON":      columnRoleStatus, // e.g. "BackOff" in events
+	"READY":       columnRoleReady,
+	"RESTARTS":    columnRoleRestarts,
+	"AGE":         columnRoleAge,
+	"LAST SEEN":   columnRoleAge, // in events
+	"IP":          columnRoleIP,
+	"INTERNAL-IP": columnRoleIP,
+	"EXTERNAL-IP": columnRoleIP,
+	"CLUSTER-IP":  columnRoleIP,
+	"NODE":        columnRoleNode,
+	"TARGETS":     columnRoleTargets, // in HPA
+
+	// the columns shown by -o wide
+	"NOMINATED NODE":  columnRoleNode,
+	"READINESS GATES": columnRoleReady,
+	"ENDPOINTS":       columnRoleIP,
+	"PODCIDR":         columnRoleIP,
+	"PODCIDRS":        columnRoleIP,
+	"IMAGES":          columnRoleImage,
+	"IMAGE":           columnRoleImage,
+	"SELECTOR":        columnRoleSelector,
+
+	"LABELS": columnRoleLabels, // shown by --show-labels
+}
+
+// tableColumn is a column in the header of a table.
+type tableColumn struct {
+	start int // the position where the column starts in the line
+	name  string
+	role  columnRole
+}
+
+// parseTableHeader returns the columns in the header line of a table.
+func parseTableHeader(line string) []tableColumn {
+	columns := []tableColumn{}
+	start := 0
+	for _, loc := range spaces.FindAllStringIndex(line+"  ", -1) {
+		if name := line[start:loc[0]]; name != "" {
+			columns = append(columns, tableColumn{start: start, name: name, role: columnRoles[name]})
+		}
+		start = loc[1]
+	}
+
+	return columns
+}
+
+// markLabelColumns sets the role of the label values to the columns added by -L.
+// kubectl puts them at the end of the columns (before LABELS when --show-labels is given too)
+// and shows the last part of the key in upper case, e.g. "VERSION" for "-L app.kubernetes.io/version".
+// They are found from the end because the header can be the same as the other column e.g. "NAME" for "-L app.kubernetes.io/name".
+func markLabelColumns(columns []tableColumn, labelColumns []string) {
+	i := len(columns) - 1
+	if i >= 0 && columns[i].role == columnRoleLabels {
+		i--
+	}
+
+	for j := len(labelColumns) - 1; j >= 0 && i >= 0; j, i = j-1, i-1 {
+		key := labelColumns[j]
+		if columns[i].name != strings.ToUpper(key[strings.LastIndex(key, "/")+1:]) {
+			return
+		}
+		columns[i].role = columnRoleLabelValue
+	}
+}
+
+// roleAt returns the role of the column which starts at start. It's columnRoleNone when there is no such column.
+func (tp *TablePrinter) roleAt(start int) columnRole {
+	for _, c := range tp.columns {
+		if c.start == start {
+			return c.role
+		}
+	}
+
+	return columnRoleNone
+}
+
+// styleForRole returns the style of column by the rule of role.
+// ok is false when the rule doesn't care column, then the column gets the color of its position.
+func (tp *TablePrinter) styleForRole(role columnRole, column string) (color.Style, bool) {
+	switch role {
+	case columnRoleStatus:
+		return tp.styleForStatus(column)
+	case columnRoleReady:
+		return readyStyle(column, tp.Theme)
+	case columnRoleRestarts:
+		return restartsStyle(column, tp.thresholds(), tp.Theme)
+	case columnRoleAge:
+		return ageStyle(column, tp.thresholds(), tp.Theme)
+	case columnRoleTargets:
+		return targetsStyle(column, tp.Theme)
+	}
+
+	return color.Style{}, false
+}
+
+// colorizeColumn returns column colored by the rule of role.
+// The values having a format, e.g. the images and the selectors shown by -o wide, are colored part by part.
+func (tp *TablePrinter) colorizeColumn(index int, role columnRole, i int, column string, colorsPreset []color.Style) string {
+	// the color of the position is always decided so that the other columns get the same colors as usual
+	style := tp.decideColumnColor(index, role, i, column, colorsPreset)
+	if role == columnRoleNone {
+		return color.Apply(column, style)
+	}
+
+	if column == "<none>" {
+		return color.Apply(column, tp.Theme.Columns.None)
+	}
+
+	if tp.StableColors {
+		switch role {
+		case columnRoleNamespace, columnRoleNode:
+			return color.Apply(column, stableColor(column, tp.Theme.Table))
+		case columnRoleName:
+			return color.Apply(column, stableColor(ownerPrefix(column), tp.Theme.Table))
+		}
+	}
+
+	switch role {
+	case columnRoleIP:
+		if colored, ok := colorizeIPs(column, tp.Theme); ok {
+			return colored
+		}
+	case columnRoleImage:
+		return colorizeImages(column, tp.Theme)
+	case columnRoleSelector, columnRoleLabels:
+		return colorizeKeyValues(column, tp.Theme)
+	case columnRoleLabelValue:
+		return color.Apply(column, tp.Theme.Columns.Value)
+	}
+
+	return color.Apply(column, style)
+}
+
+// styleForStatus returns the style of a status e.g. "Running", "Init:CrashLoopBackOff" and "Ready,SchedulingDisabled".
+// When the status consists of several words, the most severe one decides the style.
+func (tp *TablePrinter) styleForStatus(status string) (color.Style, bool) {
+	worst := -1
+	for _, s := range strings.Split(status, ",") {
+		rank := -1
+		if strings.HasPrefix(s, "Init:") {
+			// e.g. "Init:0/1" is waiting for the init containers
+			s = strings.TrimPrefix(s, "Init:")
+			rank = severityRank(StatusWarning)
+		}
+
+		if severity, ok := tp.Statuses.Severity(s); ok && severityRank(severity) > rank {
+			rank = severityRank(severity)
+		}
+		if rank > worst {
+			worst = rank
+		}
+	}
+
+	if worst == -1 {
+		return color.Style{}, false
+	}
+
+	return severityStyle(StatusSeverities[worst], tp.Theme), true
+}
+
+// parseReady parses the readiness shown as "n/m" e.g. "1/2" in READY column.
+func parseReady(s string) (ready, total int, ok bool) {
+	r, t, found := strings.Cut(s, "/")
+	if !found {
+		return 0, 0, false
+	}
+
+	ready, err := strconv.Atoi(r)
+	if err != nil {
+		return 0, 0, false
+	}
+	total, err = strconv.Atoi(t)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return ready, total, true
+}
+
+func (tp *TablePrinter) thresholds() *Thresholds {
+	if tp.Thresholds == nil {
+		return DefaultThresholds()
+	}
+
+	return tp.Thresholds
+}
@@ -13,7 +13,7 @@ import (
 func Test_TablePrinter_Print(t *testing.T) {
 	tests := []struct {
 		name           string
-		colorDeciderFn func(index int, column string) (color.Color, bool)
+		colorDeciderFn func(index int, column string) (color.Style, bool)
 		withHeader     bool
 		darkBackground bool
 		input          string
@@ -31,9 +31,9 @@ func Test_TablePrinter_Print(t *testing.T) {
 				nginx-qdf9b   1/1     Running   0          6d6h`),
 			expected: testutil.NewHereDoc(`
 				[37mNAME          READY   STATUS    RESTARTS   AGE[0m
-				[36mnginx-dnmv5[0m   [32m1/1[0m     [35mRunning[0m   [37m0[0m          [33m6d6h[0m
-				[36mnginx-m8pbc[0m   [32m1/1[0m     [35mRunning[0m   [37m0[0m          [33m6d6h[0m
-				[36mnginx-qdf9b[0m   [32m1/1[0m     [35mRunning[0m   [37m0[0m          [33m6d6h[0m
+				[36mnginx-dnmv5[0m   [32m1/1[0m     [32mRunning[0m   [37m0[0m          [33m6d6h[0m
+				[36mnginx-m8pbc[0m   [32m1/1[0m     [32mRunning[0m   [37m0[0m          [33m6d6h[0m
+				[36mnginx-qdf9b[0m   [32m1/1[0m     [32mRunning[0m   [37m0[0m          [33m6d6h[0m
 			`),
 		},
 		{
@@ -53,13 +53,32 @@ func Test_TablePrinter_Print(t *testing.T) {
 			`),
 			expected: testutil.NewHereDoc(`
 				[37mNAME                         READY   STATUS    RESTARTS   AGE[0m
-				[36mpod/nginx-8spn9[0m              [32m1/1[0m     [35mRunning[0m   [37m1[0m          [33m19d[0m
-				[36mpod/nginx-dplns[0m              [32m1/1[0m     [35mRunning[0m   [37m1[0m          [33m19d[0m
-				[36mpod/nginx-lpv5x[0m              [32m1/1[0m     [35mRunning[0m   [37m1[0m          [33m19d[0m
-				[37m[0m
+				[36mpod/nginx-8spn9[0m              [32m1/1[0m     [32mRunning[0m   [37m1[0m          [33m19d[0m
+				[36mpod/nginx-dplns[0m              [32m1/1[0m     [32mRunning[0m   [37m1[0m          [33m19d[0m
+				[36mpod/nginx-lpv5x[0m              [32m1/1[0m     [32mRunning[0m   [37m1[0m          [33m19d[0m
+
 				[37mNAME                               DESIRED   CURRENT   READY   AGE[0m
-				[36mreplicaset.apps/nginx[0m              [36m3[0m         [32m3[0m         [35m3[0m       [37m19d[0m
-				[36mreplicaset.apps/nginx-6799fc88d8[0m   [36m3[0m         [32m3[0m         [35m3[0m       [37m19d[0m
+				[36mreplicaset.apps/nginx[0m              [32m3[0m         [35m3[0m         [37m3[0m       [33m19d[0m
+				[36mreplicaset.apps/nginx-6799fc88d8[0m   [32m3[0m         [35m3[0m         [37m3[0m       [33m19d[0m
+			`),
+		},
+		{
+			name:           "a line in upper case is not a header unless it's the first line of a table",
+			colorDeciderFn: nil,
+			withHeader:     true,
+			darkBackground: true,
+			input: testutil.NewHereDoc(`
+				NAME      DATA   AGE
+				APP-ENV   2      19d
+				
+				NAME      TYPE     DATA   AGE
+				TLS       Opaque   1      19d`),
+			expected: testutil.NewHereDoc(`
+				[37mNAME      DATA   AGE[0m
+				[36mAPP-ENV[0m   [32m2[0m      [35m19d[0m
+				
+				[37mNAME      TYPE     DATA   AGE[0m
+				[36mTLS[0m       [32mOpaque[0m   [35m1[0m      [37m19d[0m
 			`),
 		},
 		{
@@ -89,16 +108,16 @@ func Test_TablePrinter_Print(t *testing.T) {
 				nginx-qdf9b   1/1     Running   0          6d6h`),
 			expected: testutil.NewHereDoc(`
 				[30mNAME          READY   STATUS    RESTARTS   AGE[0m
-				[36mnginx-dnmv5[0m   [32m1/1[0m     [35mRunning[0m   [30m0[0m          [33m6d6h[0m
-				[36mnginx-m8pbc[0m   [32m1/1[0m     [35mRunning[0m   [30m0[0m          [33m6d6h[0m
-				[36mnginx-qdf9b[0m   [32m1/1[0m     [35mRunning[0m   [30m0[0m          [33m6d6h[0m
+				[36mnginx-dnmv5[0m   [32m1/1[0m     [32mRunning[0m   [30m0[0m          [33m6d6h[0m
+				[36mnginx-m8pbc[0m   [32m1/1[0m     [32mRunning[0m   [30m0[0m          [33m6d6h[0m
+				[36mnginx-qdf9b[0m   [32m1/1[0m     [32mRunning[0m   [30m0[0m          [33m6d6h[0m
 			`),
 		},
 		{
 			name: "colorDeciderFn works",
-			colorDeciderFn: func(_ int, column string) (color.Color, bool) {
+			colorDeciderFn: func(_ int, column string) (color.Style, bool) {
 				if column == "CrashLoopBackOff" {
-					return color.Red, true
+					return color.Style{Fg: color.Red}, true
 				}
 
 				// When Readiness is "n/m" then yellow
@@ -107,13 +126,13 @@ func Test_TablePrinter_Print(t *testing.T) {
 						_, e1 := strconv.Atoi(arr[0])
 						_, e2 := strconv.Atoi(arr[1])
 						if e1 == nil && e2 == nil { // check both is number
-							return color.Yellow, true
+							return color.Style{Fg: color.Yellow}, true
 						}
 					}
 
 				}
 
-				return 0, false
+				return color.Style{}, false
 			},
 			withHeader:     true,
 			darkBackground: true,
@@ -126,8 +145,146 @@ func Test_TablePrinter_Print(t *testing.T) {
 			expected: testutil.NewHereDoc(`
 				[37mNAME          READY   STATUS             RESTARTS   AGE[0m
 				[36mnginx-dnmv5[0m   [32m1/1[0m     [31mCrashLoopBackOff[0m   [37m0[0m          [33m6d6h[0m
-				[36mnginx-m8pbc[0m   [32m1/1[0m     [35mRunning[0m            [37m0[0m          [33m6d6h[0m
-				[36mnginx-qdf9b[0m   [33m0/1[0m     [35mRunning[0m            [37m0[0m          [33m6d6h[0m
+				[36mnginx-m8pbc[0m   [32m1/1[0m     [32mRunning[0m            [37m0[0m          [33m6d6h[0m
+				[36mnginx-qdf9b[0m   [31m0/1[0m     [32mRunning[0m            [37m0[0m          [33m6d6h[0m
+			`),
+		},
+		{
+			name:           "columns are colored by the roles told by the header",
+			colorDeciderFn: nil,
+			withHeader:     true,
+			darkBackground: true,
+			input: testutil.NewHereDoc(`
+				NAME          READY   STATUS             RESTARTS   AGE
+				nginx-dnmv5   0/1     ImagePullBackOff   0          6d6h
+				nginx-m8pbc   0/1     Init:0/1           0          6d6h
+				nginx-qdf9b   1/1     Running            0          6d6h`),
+			expected: testutil.NewHereDoc(`
+				[37mNAME          READY   STATUS             RESTARTS   AGE[0m
+				[36mnginx-dnmv5[0m   [31m0/1[0m     [31mImagePullBackOff[0m   [37m0[0m          [33m6d6h[0m
+				[36mnginx-m8pbc[0m   [31m0/1[0m     [33mInit:0/1[0m           [37m0[0m          [33m6d6h[0m
+				[36mnginx-qdf9b[0m   [32m1/1[0m     [32mRunning[0m            [37m0[0m          [33m6d6h[0m
+			`),
+		},
+		{
+			name:           "status words are colored only in STATUS column",
+			colorDeciderFn: nil,
+			withHeader:     true,
+			darkBackground: true,
+			input: testutil.NewHereDoc(`
+				NAME     STATUS                     MESSAGE
+				node-1   Ready,SchedulingDisabled   Failed
+				node-2   NotReady                   Unknown`),
+			expected: testutil.NewHereDoc(`
+				[37mNAME     STATUS                     MESSAGE[0m
+				[36mnode-1[0m   [33mReady,SchedulingDisabled[0m   [35mFailed[0m
+				[36mnode-2[0m   [31mNotReady[0m                   [35mUnknown[0m
+			`),
+		},
+		{
+			name:           "numbers are colored by thresholds",
+			colorDeciderFn: nil,
+			withHeader:     true,
+			darkBackground: true,
+			input: testutil.NewHereDoc(`
+				NAME          READY   STATUS    RESTARTS       AGE
+				nginx-dnmv5   2/3     Running   5 (2m ago)     3d4h
+				nginx-m8pbc   3/3     Running   12 (45s ago)   2m30s
+				nginx-qdf9b   0/0     Running   3              45s`),
+			expected: testutil.NewHereDoc(`
+				[37mNAME          READY   STATUS    RESTARTS       AGE[0m
+				[36mnginx-dnmv5[0m   [33m2/3[0m     [32mRunning[0m   [33m5 (2m ago)[0m     [33m3d4h[0m
+				[36mnginx-m8pbc[0m   [32m3/3[0m     [32mRunning[0m   [31m12 (45s ago)[0m   [1;36m2m30s[0m
+				[36mnginx-qdf9b[0m   [32m0/0[0m     [32mRunning[0m   [37m3[0m              [1;36m45s[0m
+			`),
+		},
+		{
+			name:           "HPA targets",
+			colorDeciderFn: nil,
+			withHeader:     true,
+			darkBackground: true,
+			input: testutil.NewHereDoc(`
+				NAME    REFERENCE          TARGETS            MINPODS   MAXPODS   REPLICAS   AGE
+				api     Deployment/api     cpu: 85%/80%       1         10        4          10d
+				web     Deployment/web     10%/70%, 20%/80%   1         10        1          10d
+				batch   Deployment/batch   <unknown>/80%      1         10        1          10d`),
+			expected: testutil.NewHereDoc(`
+				[37mNAME    REFERENCE          TARGETS            MINPODS   MAXPODS   REPLICAS   AGE[0m
+				[36mapi[0m     [32mDeployment/api[0m     [33mcpu: 85%/80%[0m       [37m1[0m         [33m10[0m        [36m4[0m          [32m10d[0m
+				[36mweb[0m     [32mDeployment/web[0m     [32m10%/70%, 20%/80%[0m   [37m1[0m         [33m10[0m        [36m1[0m          [32m10d[0m
+				[36mbatch[0m   [32mDeployment/batch[0m   [31m<unknown>/80%[0m      [37m1[0m         [33m10[0m        [36m1[0m          [32m10d[0m
+			`),
+		},
+		{
+			name:           "columns are cut at the positions in the header",
+			colorDeciderFn: nil,
+			withHeader:     true,
+			darkBackground: true,
+			// the blank OWNER and the double spaces in MESSAGE don't shift the other columns
+			input: testutil.NewHereDoc(`
+				NAME          OWNER          NODE     MESSAGE
+				web-0                        node-1   Back-off  restarting
+				web-1         replicaset/a   node-2   ok`),
+			expected: testutil.NewHereDoc(`
+				[37mNAME          OWNER          NODE     MESSAGE[0m
+				[36mweb-0[0m                        [35mnode-1[0m   [37mBack-off  restarting[0m
+				[36mweb-1[0m         [32mreplicaset/a[0m   [35mnode-2[0m   [37mok[0m
+			`),
+		},
+		{
+			name:           "the lines of -w are colored by the header even when they are narrower",
+			colorDeciderFn: nil,
+			withHeader:     true,
+			darkBackground: true,
+			// kubectl get -w measures the widths of the columns for each event
+			input: testutil.NewHereDoc(`
+				NAME          READY   STATUS    RESTARTS   AGE
+				nginx-dnmv5   0/1     Pending   0          0s
+				nginx-dnmv5   0/1   ContainerCreating   0     0s
+				nginx-dnmv5   1/1   Running   0     2s`),
+			expected: testutil.NewHereDoc(`
+				[37mNAME          READY   STATUS    RESTARTS   AGE[0m
+				[36mnginx-dnmv5[0m   [31m0/1[0m     [33mPending[0m   [37m0[0m          [1;36m0s[0m
+				[36mnginx-dnmv5[0m   [31m0/1[0m   [33mContainerCreating[0m   [37m0[0m     [1;36m0s[0m
+				[36mnginx-dnmv5[0m   [32m1/1[0m   [32mRunning[0m   [37m0[0m     [1;36m2s[0m
+			`),
+		},
+		{
+			name:           "a line which doesn't fit the header is split by spaces",
+			colorDeciderFn: nil,
+			withHeader:     true,
+			darkBackground: true,
+			input: testutil.NewHereDoc(`
+				NAME    READY   STATUS
+				web-0-long-name 1/1   Running
+				web-1   0/1     Pending`),
+			expected: testutil.NewHereDoc(`
+				[37mNAME    READY   STATUS[0m
+				[36mweb-0-long-name 1/1[0m   [32mRunning[0m
+				[36mweb-1[0m   [31m0/1[0m     [33mPending[0m
+			`),
+		},
+		{
+			name:           "the columns of -o wide are colored by their formats",
+			colorDeciderFn: nil,
+			withHeader:     true,
+			darkBackground: true,
+			input: testutil.NewHereDoc(`
+				NAME    READY   UP-TO-DATE   AVAILABLE   AGE   CONTAINERS   IMAGES                                        SELECTOR
+				nginx   3/3     3            3           10d   nginx        nginx:latest                                  app=nginx
+				api     2/3     3            2           10d   api,proxy    ghcr.io/acme/api:1.2,envoyproxy/envoy:v1.27   app=api,tier!=db
+
+				NAME         TYPE        CLUSTER-IP    EXTERNAL-IP   PORT(S)   AGE   SELECTOR
+				kubernetes   ClusterIP   10.96.0.1     <none>        443/TCP   10d   <none>
+				web          ClusterIP   fd00:10::2a   <pending>     80/TCP    10d   app=web`),
+			expected: testutil.NewHereDoc(`
+				[37mNAME    READY   UP-TO-DATE   AVAILABLE   AGE   CONTAINERS   IMAGES                                        SELECTOR[0m
+				[36mnginx[0m   [32m3/3[0m     [35m3[0m            [37m3[0m           [33m10d[0m   [36mnginx[0m        [36mnginx[0m[1;33m:latest[0m                                  [33mapp[0m[2m=[0m[36mnginx[0m
+				[36mapi[0m     [33m2/3[0m     [35m3[0m            [37m2[0m           [33m10d[0m   [36mapi,proxy[0m    [2mghcr.io/[0m[36macme/api[0m[32m:1.2[0m[2m,[0m[36menvoyproxy/envoy[0m[32m:v1.27[0m   [33mapp[0m[2m=[0m[36mapi[0m[2m,[0m[33mtier[0m[2m!=[0m[36mdb[0m
+
+				[37mNAME         TYPE        CLUSTER-IP    EXTERNAL-IP   PORT(S)   AGE   SELECTOR[0m
+				[36mkubernetes[0m   [32mClusterIP[0m   [34m10.96.0.1[0m     [2m<none>[0m        [33m443/TCP[0m   [36m10d[0m   [2m<none>[0m
+				[36mweb[0m          [32mClusterIP[0m   [34mfd00:10::2a[0m   [37m<pending>[0m     [33m80/TCP[0m    [36m10d[0m   [33mapp[0m[2m=[0m[36mweb[0m
 			`),
 		},
 		{
@@ -155,21 +312,21 @@ func Test_TablePrinter_Print(t *testing.T) {
 			`),
 			expected: testutil.NewHereDoc(`
 				[37mNAME                              SHORTNAMES   APIGROUP                       NAMESPACED   KIND[0m
-				[36mbindings[0m                                                                      [32mtrue[0m         [35mBinding[0m
-				[36mcomponentstatuses[0m                 [37mcs[0m                                          [32mfalse[0m        [35mComponentStatus[0m
-				[36mpods[0m                              [37mpo[0m                                          [32mtrue[0m         [35mPod[0m
-				[36mpodtemplates[0m                                                                  [32mtrue[0m         [35mPodTemplate[0m
-				[36mreplicationcontrollers[0m            [37mrc[0m                                          [32mtrue[0m         [35mReplicationController[0m
-				[36mresourcequotas[0m                    [37mquota[0m                                       [32mtrue[0m         [35mResourceQuota[0m
-				[36msecrets[0m                                                                       [32mtrue[0m         [35mSecret[0m
-				[36mserviceaccounts[0m                   [37msa[0m                                          [32mtrue[0m         [35mServiceAccount[0m
-				[36mservices[0m                          [37msvc[0m                                         [32mtrue[0m         [35mService[0m
-				[36mmutatingwebhookconfigurations[0m                  [33madmissionregistration.k8s.io[0m   [32mfalse[0m        [35mMutatingWebhookConfiguration[0m
-				[36mcustomresourcedefinitions[0m         [37mcrd,crds[0m     [33mapiextensions.k8s.io[0m           [32mfalse[0m        [35mCustomResourceDefinition[0m
-				[36mcontrollerrevisions[0m                            [33mapps[0m                           [32mtrue[0m         [35mControllerRevision[0m
-				[36mdaemonsets[0m                        [37mds[0m           [33mapps[0m                           [32mtrue[0m         [35mDaemonSet[0m
-				[36mstatefulsets[0m                      [37msts[0m          [33mapps[0m                           [32mtrue[0m         [35mStatefulSet[0m
-				[36mtokenreviews[0m                                   [33mauthentication.k8s.io[0m          [32mfalse[0m        [35mTokenReview[0m
+				[36mbindings[0m                                                                      [37mtrue[0m         [33mBinding[0m
+				[36mcomponentstatuses[0m                 [32mcs[0m                                          [37mfalse[0m        [33mComponentStatus[0m
+				[36mpods[0m                              [32mpo[0m                                          [37mtrue[0m         [33mPod[0m
+				[36mpodtemplates[0m                                                                  [37mtrue[0m         [33mPodTemplate[0m
+				[36mreplicationcontrollers[0m            [32mrc[0m                                          [37mtrue[0m         [33mReplicationController[0m
+				[36mresourcequotas[0m                    [32mquota[0m                                       [37mtrue[0m         [33mResourceQuota[0m
+				[36msecrets[0m                                                                       [37mtrue[0m         [33mSecret[0m
+				[36mserviceaccounts[0m                   [32msa[0m                                          [37mtrue[0m         [33mServiceAccount[0m
+				[36mservices[0m                          [32msvc[0m                                         [37mtrue[0m         [33mService[0m
+				[36mmutatingwebhookconfigurations[0m                  [35madmissionregistration.k8s.io[0m   [37mfalse[0m        [33mMutatingWebhookConfiguration[0m
+				[36mcustomresourcedefinitions[0m         [32mcrd,crds[0m     [35mapiextensions.k8s.io[0m           [37mfalse[0m        [33mCustomResourceDefinition[0m
+				[36mcontrollerrevisions[0m                            [35mapps[0m                           [37mtrue[0m         [33mControllerRevision[0m
+				[36mdaemonsets[0m                        [32mds[0m           [35mapps[0m                           [37mtrue[0m         [33mDaemonSet[0m
+				[36mstatefulsets[0m                      [32msts[0m          [35mapps[0m                           [37mtrue[0m         [33mStatefulSet[0m
+				[36mtokenreviews[0m                                   [35mauthentication.k8s.io[0m          [37mfalse[0m        [33mTokenReview[0m
 			`),
 		},
 	}
@@ -179,7 +336,7 @@ func Test_TablePrinter_Print(t *testing.T) {
 			t.Parallel()
 			r := strings.NewReader(tt.input)
 			var w bytes.Buffer
-			printer := NewTablePrinter(tt.withHeader, tt.darkBackground, tt.colorDeciderFn)
+			printer := NewTablePrinter(tt.withHeader, ThemeForBackground(tt.darkBackground), tt.colorDeciderFn)
 			printer.Print(r, &w)
 			testutil.MustEqual(t, tt.expected, w.String())
 		})
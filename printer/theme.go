@@ -0,0 +1,180 @@
+package printer
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hidetatz/kubecolor/color"
+	"gopkg.in/yaml.v3"
+)
+
+// Theme is a set of styles printers use for each semantic slot.
+// Each slot can have attributes like bold as well as colors.
+// Every printer receives a Theme instead of picking colors by itself.
+type Theme struct {
+	// Key colors are used for keys in structured data (e.g. Json, Yaml, kubectl-describe format)
+	// and they are cycled by the depth of the indentation.
+	Key    []color.Style `yaml:"key"`
+	String color.Style   `yaml:"string"`
+	Bool   color.Style   `yaml:"bool"`
+	Number color.Style   `yaml:"number"`
+	Null   color.Style   `yaml:"null"`
+	Header color.Style   `yaml:"header"` // for plain table
+
+	// Section is used for section titles in kubectl-describe format e.g. "Containers:"
+	Section color.Style `yaml:"section"`
+
+	// Table colors are assigned to table columns one by one.
+	Table []color.Style `yaml:"table"`
+
+	// Kind and Name are used for the resources shown as "kind/name" e.g. "deployment.apps/nginx" in -o name
+	Kind color.Style `yaml:"kind"`
+	Name color.Style `yaml:"name"`
+
+	// Recent is used for the values telling something happened just now e.g. a young AGE in tables
+	Recent color.Style `yaml:"recent"`
+
+	Default color.Style `yaml:"default"` // for the output which kubecolor doesn't know how to colorize
+	Help    color.Style `yaml:"help"`
+	Success color.Style `yaml:"success"`
+	Warning color.Style `yaml:"warning"`
+	Error   color.Style `yaml:"error"`
+
+	Apply     ApplyTheme     `yaml:"apply"`
+	Logs      LogsTheme      `yaml:"logs"`
+	Columns   ColumnsTheme   `yaml:"columns"`
+	Diff      DiffTheme      `yaml:"diff"`
+	Route     RouteTheme     `yaml:"route"`
+	OpenShift OpenShiftTheme `yaml:"openshift"`
+}
+
+// ApplyTheme is colors for the actions shown by kubectl apply.
+type ApplyTheme struct {
+	Created    color.Style `yaml:"created"`
+	Configured color.Style `yaml:"configured"`
+	Unchanged  color.Style `yaml:"unchanged"`
+	DryRun     color.Style `yaml:"dryRun"`
+}
+
+// LogsTheme is colors for kubectl logs.
+type LogsTheme struct {
+	Timestamp color.Style `yaml:"timestamp"` // added by --timestamps
+	Fatal     color.Style `yaml:"fatal"`
+	Error     color.Style `yaml:"error"`
+	Warn      color.Style `yaml:"warn"`
+	Info      color.Style `yaml:"info"`
+	Debug     color.Style `yaml:"debug"`
+	Trace     color.Style `yaml:"trace"`
+	Message   color.Style `yaml:"message"` // in structured logs
+	Caller    color.Style `yaml:"caller"`  // e.g. "main.go:10" in klog
+}
+
+// ColumnsTheme is colors for the values of the table columns which have their own formats, e.g. in kubectl get -o wide and --show-labels.
+type ColumnsTheme struct {
+	IP         color.Style `yaml:"ip"`         // IP addresses and CIDRs
+	None       color.Style `yaml:"none"`       // "<none>"
+	Registry   color.Style `yaml:"registry"`   // e.g. "registry.k8s.io/" in "registry.k8s.io/pause:3.9"
+	Repository color.Style `yaml:"repository"` // e.g. "pause"
+	Tag        color.Style `yaml:"tag"`        // e.g. ":3.9"
+	LatestTag  color.Style `yaml:"latestTag"`  // ":latest", which is not pinned to a version
+	Key        color.Style `yaml:"key"`        // e.g. "app" in the selector "app=nginx"
+	KeyPrefix  color.Style `yaml:"keyPrefix"`  // the well-known prefixes of label keys e.g. "app.kubernetes.io/"
+	Value      color.Style `yaml:"value"`      // e.g. "nginx"
+	Separator  color.Style `yaml:"separator"`  // e.g. "=" and ","
+}
+
+// DiffTheme is colors for the unified diff shown by kubectl diff.
+type DiffTheme struct {
+	Header           color.Style `yaml:"header"` // e.g. "diff -u -N ...", "--- ...", "+++ ..."
+	Hunk             color.Style `yaml:"hunk"`   // e.g. "@@ -6,7 +6,7 @@"
+	Added            color.Style `yaml:"added"`
+	Removed          color.Style `yaml:"removed"`
+	AddedHighlight   color.Style `yaml:"addedHighlight"`   // for the changed words in an added line
+	RemovedHighlight color.Style `yaml:"removedHighlight"` // for the changed words in a removed line
+}
+
+// RouteTheme is colors for the fields of OpenShift routes in describe output.
+type RouteTheme struct {
+	Key            color.Style `yaml:"key"`
+	ResourceName   color.Style `yaml:"resourceName"`
+	Endpoint       color.Style `yaml:"endpoint"`
+	TLSEdge        color.Style `yaml:"tlsEdge"`
+	TLSPassthrough color.Style `yaml:"tlsPassthrough"`
+	TLSReencrypt   color.Style `yaml:"tlsReencrypt"`
+	Comma          color.Style `yaml:"comma"`
+}
+
+// OpenShiftTheme is colors for the resources shown by oc status.
+type OpenShiftTheme struct {
+	Project          color.Style `yaml:"project"`
+	Service          color.Style `yaml:"service"`
+	DeploymentConfig color.Style `yaml:"deploymentConfig"`
+	URL              color.Style `yaml:"url"`
+}
+
+// ThemeForBackground returns the built-in theme which is readable on the given background.
+func ThemeForBackground(dark bool) *Theme {
+	if dark {
+		return DarkTheme()
+	}
+
+	return LightTheme()
+}
+
+// LoadTheme returns the theme named name.
+// "dark" and "light" are built-in themes, otherwise it reads dir/<name>.yaml.
+// A theme file may specify "base: dark" or "base: light" (dark by default),
+// then the slots written in the file override the base theme.
+// name must be a file name in dir, so a name having a path separator or ".." is an error.
+func LoadTheme(name, dir string) (*Theme, error) {
+	switch name {
+	case "dark":
+		return DarkTheme(), nil
+	case "light":
+		return LightTheme(), nil
+	}
+
+	if strings.ContainsAny(name, `/\`) || strings.Contains(name, "..") {
+		return nil, fmt.Errorf("theme %q must be a file name in %s", name, dir)
+	}
+
+	path := filepath.Join(dir, name+".yaml")
+	b, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("theme %q is not found in %s", name, dir)
+		}
+		return nil, fmt.Errorf("read theme %s: %w", path, err)
+	}
+
+	var header struct {
+		Base string `yaml:"base"`
+	}
+	if err := yaml.Unmarshal(b, &header); err != nil {
+		return nil, fmt.Errorf("parse theme %s: %w", path, err)
+	}
+
+	var theme *Theme
+	switch header.Base {
+	case "", "dark":
+		theme = DarkTheme()
+	case "light":
+		theme = LightTheme()
+	default:
+		return nil, fmt.Errorf("parse theme %s: base must be dark or light, got %q", path, header.Base)
+	}
+
+	if err := yaml.Unmarshal(b, theme); err != nil {
+		return nil, fmt.Errorf("parse theme %s: %w", path, err)
+	}
+
+	if len(theme.Key) == 0 || len(theme.Table) == 0 {
+		return nil, fmt.Errorf("parse theme %s: key and table must have at least one color", path)
+	}
+
+	return theme, nil
+}
@@ -0,0 +1,78 @@
+package printer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hidetatz/kubecolor/color"
+	"github.com/hidetatz/kubecolor/testutil"
+)
+
+func Test_LoadTheme(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"solarized.yaml": testutil.NewHereDoc(`
+			base: light
+			string: cyan
+			table: [blue, magenta]
+			apply:
+			  created: bold cyan`),
+		"nobase.yaml":  `error: magenta`,
+		"invalid.yaml": `string: purple`,
+		"badbase.yaml": `base: gray`,
+		"notable.yaml": `table: []`,
+		"broken.yaml":  `table: [`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the themes outside dir must not be read even if they exist
+	if err := os.MkdirAll(filepath.Join(dir, "sub"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "sub", "nested.yaml"), []byte(files["nobase.yaml"]), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	solarized := LightTheme()
+	solarized.String = color.Style{Fg: color.Cyan}
+	solarized.Table = []color.Style{{Fg: color.Blue}, {Fg: color.Magenta}}
+	solarized.Apply.Created = color.Style{Fg: color.Cyan, Attrs: color.Bold}
+
+	nobase := DarkTheme()
+	nobase.Error = color.Style{Fg: color.Magenta}
+
+	tests := []struct {
+		name      string
+		expected  *Theme
+		expectErr bool
+	}{
+		{"dark", DarkTheme(), false},
+		{"light", LightTheme(), false},
+		{"solarized", solarized, false},
+		{"nobase", nobase, false},
+		{"invalid", nil, true},
+		{"badbase", nil, true},
+		{"notable", nil, true},
+		{"broken", nil, true},
+		{"notfound", nil, true},
+		{"../" + filepath.Base(dir) + "/nobase", nil, true},
+		{"sub/nested", nil, true},
+		{`sub\nested`, nil, true},
+		{"..", nil, true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := LoadTheme(tt.name, dir)
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			testutil.MustEqual(t, tt.expected, got)
+		})
+	}
+}
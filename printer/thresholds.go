@@ -0,0 +1,141 @@
+package printer
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hidetatz/kubecolor/color"
+)
+
+// Thresholds decides when the numbers in tables get the warning or error color.
+type Thresholds struct {
+	// RESTARTS is shown in the warning color when it's above RestartsWarning, and in the error color above RestartsError.
+	RestartsWarning int `yaml:"restartsWarning"`
+	RestartsError   int `yaml:"restartsError"`
+
+	// AGE younger than YoungAge is shown in the recent color, e.g. the pods which have just been replaced.
+	YoungAge time.Duration `yaml:"youngAge"`
+
+	// The percentages in kubectl top, and the usages next to them, are shown in the warning color
+	// when they are UsageWarning% or more, and in the error color when they are UsageError% or more.
+	UsageWarning int `yaml:"usageWarning"`
+	UsageError   int `yaml:"usageError"`
+}
+
+// DefaultThresholds returns the thresholds used when the config file doesn't override them.
+func DefaultThresholds() *Thresholds {
+	return &Thresholds{
+		RestartsWarning: 3,
+		RestartsError:   10,
+		YoungAge:        5 * time.Minute,
+		UsageWarning:    70,
+		UsageError:      90,
+	}
+}
+
+var (
+	// age is the age which kubectl shows e.g. "45s", "2m30s", "3d4h" and "2y30d"
+	age = regexp.MustCompile(`^(?:(\d+)y)?(?:(\d+)d)?(?:(\d+)h)?(?:(\d+)m)?(?:(\d+)s)?$`)
+
+	// hpaTarget is a pair of the current and target values of a metric in HPA TARGETS e.g. "85%/80%" and "<unknown>/80%"
+	hpaTarget = regexp.MustCompile(`(<unknown>|\d+(?:\.\d+)?)([a-zA-Z%]*)/(\d+(?:\.\d+)?)([a-zA-Z%]*)`)
+)
+
+var ageUnits = []time.Duration{365 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+
+// parseAge parses the age which kubectl shows. ok is false when s is not an age, e.g. "<unknown>".
+func parseAge(s string) (d time.Duration, ok bool) {
+	m := age.FindStringSubmatch(s)
+	if m == nil || s == "" {
+		return 0, false
+	}
+
+	for i, unit := range ageUnits {
+		if m[i+1] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(m[i+1])
+		if err != nil {
+			return 0, false
+		}
+		d += time.Duration(n) * unit
+	}
+
+	return d, true
+}
+
+// restartsStyle returns the style of the restart count e.g. "5" and "5 (2m ago)".
+func restartsStyle(s string, thresholds *Thresholds, theme *Theme) (color.Style, bool) {
+	count, _, _ := strings.Cut(s, " ")
+	n, err := strconv.Atoi(count)
+	if err != nil {
+		return color.Style{}, false
+	}
+
+	switch {
+	case n > thresholds.RestartsError:
+		return theme.Error, true
+	case n > thresholds.RestartsWarning:
+		return theme.Warning, true
+	}
+
+	return color.Style{}, false
+}
+
+// ageStyle returns the style of the age e.g. "45s" and "3d4h".
+func ageStyle(s string, thresholds *Thresholds, theme *Theme) (color.Style, bool) {
+	if d, ok := parseAge(s); ok && d < thresholds.YoungAge {
+		return theme.Recent, true
+	}
+
+	return color.Style{}, false
+}
+
+// readyStyle returns the style of the readiness "n/m" e.g. "0/3" in the error color, "2/3" in the warning color
+// and "3/3" in the success color.
+func readyStyle(s string, theme *Theme) (color.Style, bool) {
+	ready, total, ok := parseReady(s)
+	switch {
+	case !ok, total == 0:
+		return color.Style{}, false
+	case ready == 0:
+		return theme.Error, true
+	case ready < total:
+		return theme.Warning, true
+	}
+
+	return theme.Success, true
+}
+
+// targetsStyle returns the style of HPA TARGETS e.g. "85%/80%" in the warning color because it's over the target,
+// and "<unknown>/80%" in the error color because the metric is not available.
+// When there are several metrics e.g. "85%/80%, 10%/70%", the worst one decides the style.
+func targetsStyle(s string, theme *Theme) (color.Style, bool) {
+	pairs := hpaTarget.FindAllStringSubmatch(s, -1)
+	if len(pairs) == 0 {
+		return color.Style{}, false
+	}
+
+	worst := StatusHealthy
+	for _, pair := range pairs {
+		severity := StatusHealthy
+		if pair[1] == "<unknown>" {
+			severity = StatusUnhealthy
+		} else if current, target := parseFloat(pair[1]), parseFloat(pair[3]); pair[2] == pair[4] && current >= target {
+			severity = StatusWarning
+		}
+
+		if severityRank(severity) > severityRank(worst) {
+			worst = severity
+		}
+	}
+
+	return severityStyle(worst, theme), true
+}
+
+func parseFloat(s string) float64 {
+	f, _ := strconv.ParseFloat(s, 64)
+	return f
+}
@@ -0,0 +1,35 @@
+package printer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hidetatz/kubecolor/testutil"
+)
+
+func Test_parseAge(t *testing.T) {
+	tests := []struct {
+		s          string
+		expected   time.Duration
+		expectedOK bool
+	}{
+		{"45s", 45 * time.Second, true},
+		{"2m30s", 2*time.Minute + 30*time.Second, true},
+		{"3h4m", 3*time.Hour + 4*time.Minute, true},
+		{"3d4h", 3*24*time.Hour + 4*time.Hour, true},
+		{"2y30d", 2*365*24*time.Hour + 30*24*time.Hour, true},
+		{"<unknown>", 0, false},
+		{"<invalid>", 0, false},
+		{"", 0, false},
+		{"4", 0, false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.s, func(t *testing.T) {
+			t.Parallel()
+			d, ok := parseAge(tt.s)
+			testutil.MustEqual(t, tt.expected, d)
+			testutil.MustEqual(t, tt.expectedOK, ok)
+		})
+	}
+}
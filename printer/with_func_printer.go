@@ -10,7 +10,7 @@ import (
 
 // WithFuncPrinter is a printer to print something based on injected logic.
 type WithFuncPrinter struct {
-	Fn func(line string) color.Color
+	Fn func(line string) color.Styler
 }
 
 // Print reads r then writes it in w but its color is decided by
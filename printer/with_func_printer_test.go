@@ -12,13 +12,13 @@ import (
 func Test_WithFuncPrinter_Print(t *testing.T) {
 	tests := []struct {
 		name     string
-		fn       func(line string) color.Color
+		fn       func(line string) color.Styler
 		input    string
 		expected string
 	}{
 		{
 			name: "colored in white",
-			fn: func(_ string) color.Color {
+			fn: func(_ string) color.Styler {
 				return color.White
 			},
 			input: testutil.NewHereDoc(`
@@ -33,7 +33,7 @@ func Test_WithFuncPrinter_Print(t *testing.T) {
 		},
 		{
 			name: "color changes by line",
-			fn: func(line string) color.Color {
+			fn: func(line string) color.Styler {
 				if line == "test2" {
 					return color.Red
 				}
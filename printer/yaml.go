@@ -10,27 +10,99 @@ import (
 )
 
 type YamlPrinter struct {
-	DarkBackground bool
+	Theme *Theme
+	// Statuses tells the severities of the values of the status fields e.g. "phase: Running" and the reasons of conditions.
+	// The default statuses are used when it's nil.
+	Statuses StatusRegistry
 
 	inString bool
+
+	// the conditions being read e.g. "status.conditions" of a pod. Each of them is printed when it ends
+	// because its status is colored by its type, which comes after the status.
+	inConditions     bool
+	conditionsIndent int      // the indent of "conditions:"
+	conditionIndent  int      // the indent of "- " of each condition
+	condition        []string // the lines of the condition being read
+	conditionType    string   // the type of the condition being printed e.g. "Ready"
+}
+
+// yamlStatusKeys are the keys whose values are statuses
+var yamlStatusKeys = map[string]bool{
+	"phase":  true,
+	"reason": true,
+	"status": true,
 }
 
 func (yp *YamlPrinter) Print(r io.Reader, w io.Writer) {
 	scanner := bufio.NewScanner(r)
 	for scanner.Scan() {
-		line := scanner.Text()
-		yp.printLineAsYamlFormat(line, w, yp.DarkBackground)
+		yp.printLine(scanner.Text(), w)
+	}
+	yp.printCondition(w)
+}
+
+// printLine prints line, or keeps it until the condition which it belongs to ends.
+func (yp *YamlPrinter) printLine(line string, w io.Writer) {
+	indentCnt := findIndent(line)
+	trimmedLine := strings.TrimLeft(line, " ")
+
+	if yp.inConditions {
+		isItem := strings.HasPrefix(trimmedLine, "- ") || trimmedLine == "-"
+		switch {
+		case yp.condition == nil && isItem && indentCnt >= yp.conditionsIndent:
+			// kubectl puts the items of a list at the same indent as its key
+			yp.conditionIndent = indentCnt
+			yp.condition = []string{line}
+			return
+		case yp.condition != nil && isItem && indentCnt == yp.conditionIndent:
+			yp.printCondition(w)
+			yp.condition = []string{line}
+			return
+		case yp.condition != nil && indentCnt > yp.conditionIndent:
+			yp.condition = append(yp.condition, line)
+			return
+		}
+
+		yp.printCondition(w)
+		yp.inConditions = false
+	}
+
+	yp.printLineAsYamlFormat(line, w, yp.Theme)
+
+	if trimmedLine == "conditions:" && !yp.inString {
+		yp.inConditions = true
+		yp.conditionsIndent = indentCnt
 	}
 }
 
-func (yp *YamlPrinter) printLineAsYamlFormat(line string, w io.Writer, dark bool) {
+// printCondition prints the lines of the condition which has been read, with its status colored by its type.
+func (yp *YamlPrinter) printCondition(w io.Writer) {
+	if yp.condition == nil {
+		return
+	}
+
+	for _, line := range yp.condition {
+		key, val, found := strings.Cut(strings.TrimPrefix(strings.TrimLeft(line, " "), "- "), ": ")
+		if found && key == "type" && findIndent(line) <= yp.conditionIndent+2 {
+			yp.conditionType = strings.Trim(val, `"`)
+		}
+	}
+
+	for _, line := range yp.condition {
+		yp.printLineAsYamlFormat(line, w, yp.Theme)
+	}
+	yp.condition = nil
+	yp.conditionType = ""
+}
+
+func (yp *YamlPrinter) printLineAsYamlFormat(line string, w io.Writer, theme *Theme) {
 	indentCnt := findIndent(line) // can be 0
 	indent := toSpaces(indentCnt) // so, can be empty
 	trimmedLine := strings.TrimLeft(line, " ")
 
 	if yp.inString {
 		// if inString is true, the line must be a part of a string which is broken into several lines
-		fmt.Fprintf(w, "%s%s\n", indent, yp.toColorizedStringValue(trimmedLine, dark))
+		fmt.Fprintf(w, "%s%s\n", indent, yp.toColorizedStringValue(trimmedLine, theme))
 		yp.inString = !yp.isStringClosed(trimmedLine)
 		return
 	}
@@ -40,7 +112,11 @@ func (yp *YamlPrinter) printLineAsYamlFormat(line string, w io.Writer, dark bool
 	if len(splitted) == 2 {
 		// key: value
 		key, val := splitted[0], splitted[1]
-		fmt.Fprintf(w, "%s%s: %s\n", indent, yp.toColorizedYamlKey(key, indentCnt, 2, dark), yp.toColorizedYamlValue(val, dark))
+		coloredVal, ok := yp.toColorizedYamlStatus(key, val, theme)
+		if !ok {
+			coloredVal = yp.toColorizedYamlValue(val, theme)
+		}
+		fmt.Fprintf(w, "%s%s: %s\n", indent, yp.toColorizedYamlKey(key, indentCnt, 2, theme), coloredVal)
 		yp.inString = yp.isStringOpenedButNotClosed(val)
 		return
 	}
@@ -48,14 +124,14 @@ func (yp *YamlPrinter) printLineAsYamlFormat(line string, w io.Writer, dark bool
 	// when coming here, the line is just a "key:" or an element of an array
 	if strings.HasSuffix(splitted[0], ":") {
 		// key:
-		fmt.Fprintf(w, "%s%s\n", indent, yp.toColorizedYamlKey(splitted[0], indentCnt, 2, dark))
+		fmt.Fprintf(w, "%s%s\n", indent, yp.toColorizedYamlKey(splitted[0], indentCnt, 2, theme))
 		return
 	}
 
-	fmt.Fprintf(w, "%s%s\n", indent, yp.toColorizedYamlValue(splitted[0], dark))
+	fmt.Fprintf(w, "%s%s\n", indent, yp.toColorizedYamlValue(splitted[0], theme))
 }
 
-func (yp *YamlPrinter) toColorizedYamlKey(key string, indentCnt, basicWidth int, dark bool) string {
+func (yp *YamlPrinter) toColorizedYamlKey(key string, indentCnt, basicWidth int, theme *Theme) string {
 	hasColon := strings.HasSuffix(key, ":")
 	hasLeadingDash := strings.HasPrefix(key, "- ")
 	key = strings.TrimSuffix(key, ":")
@@ -71,10 +147,10 @@ func (yp *YamlPrinter) toColorizedYamlKey(key string, indentCnt, basicWidth int,
 		indentCnt += 2
 	}
 
-	return fmt.Sprintf(format, color.Apply(key, getColorByKeyIndent(indentCnt, basicWidth, dark)))
+	return fmt.Sprintf(format, color.Apply(key, getColorByKeyIndent(indentCnt, basicWidth, theme)))
 }
 
-func (yp *YamlPrinter) toColorizedYamlValue(value string, dark bool) string {
+func (yp *YamlPrinter) toColorizedYamlValue(value string, theme *Theme) string {
 	if value == "{}" {
 		return "{}"
 	}
@@ -97,14 +173,35 @@ func (yp *YamlPrinter) toColorizedYamlValue(value string, dark bool) string {
 		format = "%s"
 	}
 
-	return fmt.Sprintf(format, color.Apply(trimmedValue, getColorByValueType(value, dark)))
+	return fmt.Sprintf(format, color.Apply(trimmedValue, getColorByValueType(value, theme)))
 }
 
-func (yp *YamlPrinter) toColorizedStringValue(value string, dark bool) string {
-	c := StringColorForLight
-	if dark {
-		c = StringColorForDark
+// toColorizedYamlStatus colors val by its severity when key is a status field e.g. "phase: Running".
+// ok is false when val is not a status.
+func (yp *YamlPrinter) toColorizedYamlStatus(key, val string, theme *Theme) (string, bool) {
+	if !yamlStatusKeys[strings.TrimPrefix(key, "- ")] {
+		return "", false
+	}
+
+	status := strings.TrimSuffix(strings.TrimPrefix(val, `"`), `"`)
+	style, ok := yp.Statuses.style(status, theme)
+	if key := strings.TrimPrefix(key, "- "); key == "status" && yp.conditionType != "" {
+		// e.g. `status: "False"` of a condition
+		style, ok = yp.Statuses.conditionStyle(yp.conditionType, status, theme)
 	}
+	if !ok {
+		return "", false
+	}
+
+	if status != val {
+		return `"` + color.Apply(status, style) + `"`, true
+	}
+
+	return color.Apply(status, style), true
+}
+
+func (yp *YamlPrinter) toColorizedStringValue(value string, theme *Theme) string {
+	c := theme.String
 
 	isDoubleQuoted := strings.HasPrefix(value, `"`) && strings.HasSuffix(value, `"`)
 	trimmedValue := strings.TrimRight(strings.TrimLeft(value, `"`), `"`)
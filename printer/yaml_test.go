@@ -128,6 +128,68 @@ func Test_YamlPrinter_Print(t *testing.T) {
 				      [37mannotation.short.1[0m: [36mnormal length annotation[0m
 			`),
 		},
+		{
+			name:           "statuses are colored by their severities",
+			darkBackground: true,
+			input: testutil.NewHereDoc(`
+				status:
+				  conditions:
+				  - reason: ContainersNotReady
+				    status: "False"
+				    type: Ready
+				  containerStatuses:
+				  - state:
+				      waiting:
+				        reason: CrashLoopBackOff
+				  phase: Running`),
+			expected: testutil.NewHereDoc(`
+				[33mstatus[0m:
+				  [37mconditions[0m:
+				  - [33mreason[0m: [33mContainersNotReady[0m
+				    [33mstatus[0m: "[31mFalse[0m"
+				    [33mtype[0m: [36mReady[0m
+				  [37mcontainerStatuses[0m:
+				  - [33mstate[0m:
+				      [37mwaiting[0m:
+				        [33mreason[0m: [31mCrashLoopBackOff[0m
+				  [37mphase[0m: [32mRunning[0m
+			`),
+		},
+		{
+			name:           "the statuses of conditions are colored by their types",
+			darkBackground: true,
+			input: testutil.NewHereDoc(`
+				status:
+				  conditions:
+				  - lastHeartbeatTime: "2023-01-02T15:04:05Z"
+				    message: kubelet has sufficient memory available
+				    reason: KubeletHasSufficientMemory
+				    status: "False"
+				    type: MemoryPressure
+				  - reason: KubeletReady
+				    status: "True"
+				    type: Ready
+				  - status: Unknown
+				    type: NetworkUnavailable
+				  nodeInfo:
+				    architecture: amd64`),
+			expected: testutil.NewHereDoc(`
+				[33mstatus[0m:
+				  [37mconditions[0m:
+				  - [33mlastHeartbeatTime[0m: "[36m2023-01-02T15:04:05Z[0m"
+				    [33mmessage[0m: [36mkubelet has sufficient memory available[0m
+				    [33mreason[0m: [36mKubeletHasSufficientMemory[0m
+				    [33mstatus[0m: "[32mFalse[0m"
+				    [33mtype[0m: [36mMemoryPressure[0m
+				  - [33mreason[0m: [36mKubeletReady[0m
+				    [33mstatus[0m: "[32mTrue[0m"
+				    [33mtype[0m: [36mReady[0m
+				  - [33mstatus[0m: [31mUnknown[0m
+				    [33mtype[0m: [36mNetworkUnavailable[0m
+				  [37mnodeInfo[0m:
+				    [33marchitecture[0m: [36mamd64[0m
+			`),
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -135,7 +197,7 @@ func Test_YamlPrinter_Print(t *testing.T) {
 			t.Parallel()
 			r := strings.NewReader(tt.input)
 			var w bytes.Buffer
-			printer := YamlPrinter{DarkBackground: tt.darkBackground}
+			printer := YamlPrinter{Theme: ThemeForBackground(tt.darkBackground)}
 			printer.Print(r, &w)
 			testutil.MustEqual(t, tt.expected, w.String())
 		})